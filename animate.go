@@ -0,0 +1,138 @@
+package prettyslice
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"golang.org/x/term"
+)
+
+// AnimateAppends appends the values to the initial slice one by one,
+// and redraws the slice after each append.
+//
+// It's useful for watching a slice grow and its backing array move.
+// The values are converted to the element type, like 1 to a float64.
+// It stops with an error line at a value that can't be converted.
+// The previous drawing is cleared if the Writer is a terminal,
+// otherwise, each step is drawn after the previous one.
+func AnimateAppends(msg string, initial interface{}, values ...interface{}) {
+	global().AnimateAppends(msg, initial, values...)
+}
+
+// AnimateAppends appends the values to the initial slice one by one,
+// and redraws the slice after each append.
+func (p *Printer) AnimateAppends(msg string, initial interface{}, values ...interface{}) {
+	s := reflect.ValueOf(initial)
+
+	pl := p.newPlayer()
+
+	pl.play(p.Sprint(msg, s.Interface()))
+	for _, v := range values {
+		if pl.tty {
+			time.Sleep(p.FrameDelay)
+		}
+
+		ev, ok := convert(v, s.Type().Elem())
+		if !ok {
+			pl.play(p.ColorIndex.Sprintf("%s: cannot append %v (%T) to %s\n", msg, v, v, s.Type()))
+			return
+		}
+		s = reflect.Append(s, ev)
+		pl.play(p.Sprint(fmt.Sprintf("%s: append(%v)", msg, v), s.Interface()))
+	}
+}
+
+// convert converts a value to the element type of a slice, like: 1 to float64.
+// It's false if the value can't be converted.
+func convert(v interface{}, t reflect.Type) (reflect.Value, bool) {
+	ev := reflect.ValueOf(v)
+	switch {
+	case !ev.IsValid():
+		// nil can only be appended to the elements that can be nil
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t), true
+		}
+		return ev, false
+	case ev.Type().AssignableTo(t):
+		return ev, true
+	case ev.CanConvert(t) && (t.Kind() != reflect.String || ev.Kind() == reflect.String):
+		// the numbers are not converted to the strings: 65 is not "A"
+		return ev.Convert(t), true
+	}
+	return ev, false
+}
+
+// player draws the frames of an animation.
+// On a terminal, each frame is drawn over the previous one.
+type player struct {
+	w      io.Writer
+	tty    bool
+	colors bool
+	lines  int
+}
+
+// newPlayer creates a player that draws to the Writer
+func (p *Printer) newPlayer() *player {
+	return &player{w: p.Writer, tty: isTerminal(p.Writer), colors: p.colorful(p.Writer)}
+}
+
+// play draws a frame
+func (pl *player) play(out string) {
+	if !pl.colors {
+		out = stripANSI(out)
+	}
+	lines := strings.Count(out, "\n")
+
+	if pl.tty && pl.lines > 0 {
+		// move the cursor to the previous drawing and clear it
+		out = fmt.Sprintf("\x1b[%dA\x1b[J", pl.lines) + out
+	}
+	pl.lines = lines
+
+	write(pl.w, out)
+}
+
+// terminalWidth returns the width of the terminal that the writer writes to.
+// It returns 0 if the writer is not a terminal.
+func terminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || !isTerminal(w) {
+		return 0
+	}
+
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// colorful is true if the colors can be drawn to the writer.
+//
+// If AutoColors is true, they're not drawn when the NO_COLOR
+// environment variable is set, or when the writer is not a terminal.
+func (p *Printer) colorful(w io.Writer) bool {
+	switch {
+	case !p.AutoColors:
+		return true
+	case os.Getenv("NO_COLOR") != "":
+		return false
+	case w == color.Output:
+		// the color package already checks the standard output
+		return true
+	}
+	return isTerminal(w)
+}
+
+// isTerminal is true if the writer is a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd()))
+}
@@ -0,0 +1,37 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnimateAppendsConverts(t *testing.T) {
+	var buf strings.Builder
+	p := NewPrinter()
+	p.Writer = &buf
+
+	p.AnimateAppends("f", []float64{}, 1, 2.5)
+
+	out := stripANSI(buf.String())
+	for _, want := range []string{"len:2", "║ 1 ║", "║ 2.5 ║"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got:\n%s\nwant %q", out, want)
+		}
+	}
+}
+
+func TestAnimateAppendsError(t *testing.T) {
+	var buf strings.Builder
+	p := NewPrinter()
+	p.Writer = &buf
+
+	p.AnimateAppends("s", []string{}, "a", 1, "b")
+
+	out := stripANSI(buf.String())
+	if !strings.Contains(out, "cannot append 1 (int) to []string") {
+		t.Errorf("got:\n%s\nwant the error", out)
+	}
+	if strings.Contains(out, "append(b)") {
+		t.Errorf("got:\n%s\nwant no appends after the error", out)
+	}
+}
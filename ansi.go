@@ -0,0 +1,126 @@
+package prettyslice
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ansiForeground and ansiBackground are the default colors
+	ansiForeground = color.RGBA{0xd0, 0xd0, 0xd0, 0xff}
+	ansiBackground = color.RGBA{0x1e, 0x1e, 0x1e, 0xff}
+
+	// ansiPalette maps the ANSI color codes to RGB colors
+	ansiPalette = [...]color.RGBA{
+		{0x00, 0x00, 0x00, 0xff}, {0xcd, 0x31, 0x31, 0xff},
+		{0x0d, 0xbc, 0x79, 0xff}, {0xe5, 0xe5, 0x10, 0xff},
+		{0x24, 0x72, 0xc8, 0xff}, {0xbc, 0x3f, 0xbc, 0xff},
+		{0x11, 0xa8, 0xcd, 0xff}, {0xe5, 0xe5, 0xe5, 0xff},
+		// high intensity colors
+		{0x66, 0x66, 0x66, 0xff}, {0xf1, 0x4c, 0x4c, 0xff},
+		{0x23, 0xd1, 0x8b, 0xff}, {0xf5, 0xf5, 0x43, 0xff},
+		{0x3b, 0x8e, 0xea, 0xff}, {0xd6, 0x70, 0xd6, 0xff},
+		{0x29, 0xb8, 0xdb, 0xff}, {0xff, 0xff, 0xff, 0xff},
+	}
+)
+
+// ansiCell is a character of a colored drawing with its colors
+type ansiCell struct {
+	r      rune
+	fg, bg color.Color
+	bold   bool
+}
+
+// terminalCells lays out the cells of a line in the terminal columns:
+// a wide rune takes two cells, and a zero width rune takes none.
+// The second cell of a wide rune is an empty cell.
+func terminalCells(l []ansiCell) []ansiCell {
+	cells := make([]ansiCell, 0, len(l))
+	for _, c := range l {
+		switch runeWidth(c.r) {
+		case 0:
+		case 2:
+			cells = append(cells, c, ansiCell{fg: c.fg, bg: c.bg, bold: c.bold})
+		default:
+			cells = append(cells, c)
+		}
+	}
+	return cells
+}
+
+// parseANSI splits the drawing into lines of colored cells
+func parseANSI(s string) [][]ansiCell {
+	var (
+		lines [][]ansiCell
+		line  []ansiCell
+
+		fg, bg color.Color = ansiForeground, nil
+		bold   bool
+	)
+
+	for len(s) > 0 {
+		// parse an escape sequence like: "\x1b[100;35;1m"
+		if strings.HasPrefix(s, "\x1b[") {
+			end := strings.IndexByte(s, 'm')
+			if end < 0 {
+				break
+			}
+			codes := strings.Split(s[2:end], ";")
+			s = s[end+1:]
+
+			for i := 0; i < len(codes); i++ {
+				n, _ := strconv.Atoi(codes[i])
+
+				switch {
+				case n == 0:
+					fg, bg, bold = ansiForeground, nil, false
+				case n == 1:
+					bold = true
+				case n == 22:
+					bold = false
+				case n == 39:
+					fg = ansiForeground
+				case n == 49:
+					bg = nil
+				case n >= 30 && n <= 37:
+					fg = ansiPalette[n-30]
+				case n >= 90 && n <= 97:
+					fg = ansiPalette[n-90+8]
+				case n >= 40 && n <= 47:
+					bg = ansiPalette[n-40]
+				case n >= 100 && n <= 107:
+					bg = ansiPalette[n-100+8]
+				case (n == 38 || n == 48) && i+4 < len(codes) && codes[i+1] == "2":
+					// 24-bit colors: 38;2;r;g;b
+					rgb := [3]uint8{}
+					for k := range rgb {
+						v, _ := strconv.Atoi(codes[i+2+k])
+						rgb[k] = uint8(v)
+					}
+					c := color.RGBA{rgb[0], rgb[1], rgb[2], 0xff}
+					if n == 38 {
+						fg = c
+					} else {
+						bg = c
+					}
+					i += 4
+				}
+			}
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+
+		if r == '\n' {
+			lines = append(lines, line)
+			line = nil
+			continue
+		}
+
+		line = append(line, ansiCell{r: r, fg: fg, bg: bg, bold: bold})
+	}
+	return append(lines, line)
+}
@@ -0,0 +1,76 @@
+package prettyslice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ShowChan pretty prints the buffer of a channel.
+//
+// It draws a box for each slot of the buffer: the slots with the queued
+// elements are filled, and the empty slots are drawn like a backing array.
+// The slot 0 has the element that will be received next.
+//
+// The queued elements can't be read without receiving them, so the slots
+// only tell whether they're queued. The len and cap of the channel are
+// drawn in the header.
+func ShowChan(msg string, ch interface{}) {
+	global().ShowChan(msg, ch)
+}
+
+// ShowChan pretty prints the buffer of a channel.
+func (p *Printer) ShowChan(msg string, ch interface{}) {
+	c := reflect.ValueOf(ch)
+	if c.Kind() != reflect.Chan {
+		p.Show(msg, ch)
+		return
+	}
+
+	// the slots are drawn as the elements of a slice:
+	// the queued ones are in the slice, and the empty ones are in its backing array.
+	cp := *p
+	cp.PrintBacking = true
+	cp.PrintElementAddr = false
+	cp.Formatter = nil
+	cp.ElementFormat = ""
+	cp.FillByValue = false
+	cp.Labels = nil
+
+	buf := new(strings.Builder)
+
+	var l, n int
+	if !c.IsNil() {
+		l, n = c.Len(), c.Cap()
+	}
+
+	slot := cp.Style.chars().slot
+	slots := make([]string, n)
+	for i := range slots {
+		slots[i] = " "
+		if i < l {
+			slots[i] = slot
+		}
+	}
+
+	d := cp.create(slots[:l], buf)
+	d.noun = "chan"
+	d.headerWith(msg, fmt.Sprintf(" (%s len:%-2d cap:%d)", c.Type(), l, n))
+	d.pushNewline()
+
+	switch {
+	case c.IsNil():
+		d.push(d.nilText() + "\n")
+	case n == 0:
+		d.push("<unbuffered chan>\n")
+	default:
+		// an empty buffer is drawn as <empty chan> with its empty slots
+		d.fills = make([]float64, l)
+		for i := range d.fills {
+			d.fills[i] = 1
+		}
+		d.body()
+	}
+
+	p.writeTo(p.Writer, buf.String())
+}
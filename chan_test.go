@@ -0,0 +1,35 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShowChan(t *testing.T) {
+	full := make(chan int, 3)
+	full <- 1
+
+	tests := []struct {
+		name string
+		ch   chan int
+		want string
+	}{
+		{"nil", nil, "<nil chan>"},
+		{"unbuffered", make(chan int), "<unbuffered chan>"},
+		{"empty", make(chan int, 2), "<empty chan>"},
+		{"queued", full, "len:1 "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			p := NewPrinter()
+			p.Writer = &buf
+			p.ShowChan("ch", tt.ch)
+
+			out := stripANSI(buf.String())
+			if !strings.Contains(out, tt.want) || strings.Contains(out, "slice") {
+				t.Errorf("got:\n%s\nwant %q", out, tt.want)
+			}
+		})
+	}
+}
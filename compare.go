@@ -0,0 +1,145 @@
+package prettyslice
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// ShowCompare pretty prints slices one below the other, under a single
+// row of index numbers.
+//
+// The elements at the same index are drawn in the same widths, so they
+// line up. The elements that are not equal to the first slice's elements
+// at the same index are drawn in ColorDiff. Each slice's len, cap and ptr
+// are drawn on its right, with its number like: #1.
+//
+// It's handy for watching whether a copy or an append affects the other slices.
+func ShowCompare(msg string, slices ...interface{}) {
+	global().ShowCompare(msg, slices...)
+}
+
+// ShowCompare pretty prints slices one below the other, under a single
+// row of index numbers.
+func (p *Printer) ShowCompare(msg string, slices ...interface{}) {
+	p, slices = p.withOptions(slices)
+	if len(slices) == 0 {
+		return
+	}
+	buf := new(strings.Builder)
+
+	// the elements are not elided: each index is in the same column
+	cp := *p
+	cp.MaxElements, cp.Head, cp.Tail = 0, 0, 0
+
+	ds := make([]drawing, len(slices))
+
+	var l, longest int
+	for i, s := range slices {
+		// each slice has its own highlights
+		dp := cp
+		dp.Highlight = make(map[int]*color.Color)
+		for k, c := range p.Highlight {
+			dp.Highlight[k] = c
+		}
+
+		ds[i] = dp.create(s, buf)
+		if ds[i].FillByValue {
+			ds[i].fills = fills(ds[i].slice)
+		}
+		if n := ds[i].length(); n > l {
+			l, longest = n, i
+		}
+	}
+
+	// draw the elements at the same index in the same widths: align them
+	widths := make([]int, l)
+	for _, d := range ds {
+		for i, v := range d.cells(0, d.length()) {
+			widths[i] = max(widths[i], d.slen(v))
+		}
+	}
+
+	first := ds[0]
+	for i, d := range ds {
+		ds[i].widths = widths
+
+		for k := 0; k < min(d.slice.Len(), first.slice.Len()); k++ {
+			if !equal(d.slice.Index(k), first.slice.Index(k)) {
+				d.Highlight[k] = p.ColorDiff
+			}
+		}
+	}
+
+	top := ds[longest]
+	top.headerWith(msg, "")
+	top.pushNewline()
+
+	step := top.MaxPerLine
+	if step <= 0 || top.FitWidth > 0 {
+		step = l
+	}
+
+	for f, t := 0, 0; f < l; f = t {
+		t = top.lineEnd(f, f+step, l)
+
+		top.indexes(f, t)
+		top.pushNewline()
+
+		for i, d := range ds {
+			d.compared(top, i, f, t)
+		}
+	}
+
+	// there are no lines when all the slices are empty
+	if l == 0 {
+		for i, d := range ds {
+			d.compared(top, i, 0, 0)
+		}
+	}
+
+	p.writeTo(p.Writer, buf.String())
+}
+
+// compared draws the [from, to) elements of the i-th slice of ShowCompare.
+// Its details are drawn on the right of the elements of the top drawing.
+func (d drawing) compared(top drawing, i, from, to int) {
+	info := fmt.Sprintf("#%d%s", i, d.info())
+
+	// the empty slices are drawn on the first line
+	if d.length() == 0 && from == 0 {
+		s := d.emptyText()
+		if d.slice.IsNil() {
+			s = d.nilText()
+		}
+		d.pushf(d.ColorIndex, "%s %s", info, s)
+		d.pushNewline()
+		return
+	}
+	if from >= d.length() {
+		return
+	}
+
+	// the width of the lines of the top drawing and this drawing
+	// +4 is for the vertical bars and the spaces around the value
+	var tw, w int
+	for _, v := range top.cells(from, to) {
+		tw += top.slen(v) + 4
+	}
+	for _, v := range d.cells(from, to) {
+		w += d.slen(v) + 4
+	}
+	pad := strings.Repeat(" ", tw-w)
+
+	cs := d.Style.chars()
+
+	d.wrap(cs.topLeft, cs.topRight, from, to)
+	d.pushNewline()
+	d.middle(from, to)
+	d.push(pad + " ")
+	d.pushf(d.ColorIndex, "%s", info)
+	d.pushNewline()
+	d.wrap(cs.bottomLeft, cs.bottomRight, from, to)
+	d.pushNewline()
+}
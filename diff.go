@@ -0,0 +1,109 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// ShowDiff pretty prints two versions of a slice one below the other,
+// and shows the differences between them.
+//
+// The elements that are changed are drawn in ColorDiff in both of them.
+// The extra elements are marked with a "-" when they're only in the before,
+// and with a "+" when they're only in the after. The inserted elements,
+// like the ones that are appended, are also drawn in ColorInsert.
+//
+// The header of the after says "moved" if its backing array is not the same
+// as the before's, like when append allocates a new backing array.
+func ShowDiff(msg string, before, after interface{}) {
+	global().ShowDiff(msg, before, after)
+}
+
+// ShowDiff pretty prints two versions of a slice one below the other,
+// and shows the differences between them.
+func (p *Printer) ShowDiff(msg string, before, after interface{}) {
+	buf := new(strings.Builder)
+
+	// the printer is copied: the changed elements are highlighted
+	dp := *p
+	dp.Highlight = make(map[int]*color.Color)
+	for i, c := range p.Highlight {
+		dp.Highlight[i] = c
+	}
+
+	b, a := dp.create(before, buf), dp.create(after, buf)
+
+	// only the longer one has the notes row for the extra elements
+	bl, al := b.slice.Len(), a.slice.Len()
+	if bl > al {
+		b.notes = make([]string, bl)
+	} else if al > bl {
+		a.notes = make([]string, al)
+	}
+
+	// draw the elements at the same index in the same widths: align them
+	widths := make([]int, max(bl, al))
+
+	for i := range widths {
+		var bv, av reflect.Value
+		if i < bl {
+			bv = b.slice.Index(i)
+			widths[i] = b.slen(b.format(i, bv))
+		}
+		if i < al {
+			av = a.slice.Index(i)
+			widths[i] = max(widths[i], a.slen(a.format(i, av)))
+		}
+
+		switch {
+		case i >= al:
+			b.notes[i] = "-"
+		case i >= bl:
+			a.notes[i] = "+"
+			dp.Highlight[i] = p.ColorInsert
+		case !equal(bv, av):
+			dp.Highlight[i] = p.ColorDiff
+		}
+	}
+	b.widths, a.widths = widths, widths
+
+	info := a.info()
+	if a.ShowPtr && moved(before, after) {
+		info = appendInfo(info, "moved")
+	}
+
+	b.draw(msg + ": before")
+
+	a.headerWith(msg+": after", info)
+	a.pushNewline()
+	a.body()
+
+	p.writeTo(p.Writer, buf.String())
+}
+
+// moved is true if two slices have different backing arrays
+func moved(before, after interface{}) bool {
+	b, a := reflect.ValueOf(before), reflect.ValueOf(after)
+	if b.Kind() != reflect.Slice || a.Kind() != reflect.Slice {
+		return false
+	}
+	if b.Cap() == 0 || a.Cap() == 0 {
+		return false
+	}
+
+	// the slices may start at different elements of the same backing array
+	bs := b.Pointer()
+	be := bs + uintptr(b.Cap())*b.Type().Elem().Size()
+	as := a.Pointer()
+	return as < bs || as >= be
+}
+
+// equal is true if two elements are deeply equal
+func equal(a, b reflect.Value) bool {
+	if !a.CanInterface() || !b.CanInterface() {
+		return a.String() == b.String()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
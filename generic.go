@@ -0,0 +1,91 @@
+package prettyslice
+
+import "strconv"
+
+// ShowOf pretty prints slices of any element type, like Show does.
+//
+// The elements of the basic types, like ints, floats and strings,
+// are printed without the reflection and the fmt package.
+func ShowOf[T any](msg string, slices ...[]T) {
+	of(global(), slices).Show(msg, anys(slices)...)
+}
+
+// SprintOf pretty prints slices of any element type into a string,
+// like Sprint does.
+func SprintOf[T any](msg string, slices ...[]T) string {
+	return of(global(), slices).Sprint(msg, anys(slices)...)
+}
+
+// of returns a copy of the Printer with the formatted elements of the slices.
+// The Printer formats the elements itself if they're not of the basic types.
+func of[T any](p *Printer, slices [][]T) *Printer {
+	// the elements are formatted as the user says
+	if p.Formatter != nil || p.ElementFormat != "" {
+		return p
+	}
+
+	values := make([][]string, len(slices))
+	for i, s := range slices {
+		if values[i] = valuesOf(p, s); values[i] == nil {
+			return p
+		}
+	}
+
+	vp := *p
+	vp.values = values
+	return &vp
+}
+
+// valuesOf formats the elements of a slice and its backing array.
+// It returns nil if the elements are not of the basic types.
+func valuesOf[T any](p *Printer, s []T) []string {
+	switch s := any(s[:cap(s)]).(type) {
+	case []int:
+		return formatAll(s, func(v int) string { return strconv.FormatInt(int64(v), 10) })
+	case []int8:
+		return formatAll(s, func(v int8) string { return strconv.FormatInt(int64(v), 10) })
+	case []int16:
+		return formatAll(s, func(v int16) string { return strconv.FormatInt(int64(v), 10) })
+	case []rune:
+		return formatAll(s, p.formatRune)
+	case []int64:
+		return formatAll(s, func(v int64) string { return strconv.FormatInt(v, 10) })
+	case []uint:
+		return formatAll(s, func(v uint) string { return strconv.FormatUint(uint64(v), 10) })
+	case []byte:
+		return formatAll(s, p.formatByte)
+	case []uint16:
+		return formatAll(s, func(v uint16) string { return strconv.FormatUint(uint64(v), 10) })
+	case []uint32:
+		return formatAll(s, func(v uint32) string { return strconv.FormatUint(uint64(v), 10) })
+	case []uint64:
+		return formatAll(s, func(v uint64) string { return strconv.FormatUint(v, 10) })
+	case []float32:
+		return formatAll(s, func(v float32) string { return strconv.FormatFloat(float64(v), 'g', -1, 32) })
+	case []float64:
+		return formatAll(s, func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) })
+	case []string:
+		return formatAll(s, p.formatString)
+	case []bool:
+		return formatAll(s, strconv.FormatBool)
+	}
+	return nil
+}
+
+// formatAll formats the elements of a slice
+func formatAll[T any](s []T, format func(T) string) []string {
+	values := make([]string, len(s))
+	for i, v := range s {
+		values[i] = format(v)
+	}
+	return values
+}
+
+// anys converts the typed slices for the reflection based drawing
+func anys[T any](slices [][]T) []interface{} {
+	s := make([]interface{}, len(slices))
+	for i, v := range slices {
+		s[i] = v
+	}
+	return s
+}
@@ -0,0 +1,67 @@
+package prettyslice
+
+import "testing"
+
+func TestSprintOf(t *testing.T) {
+	nums := make([]int, 3, 5)
+	copy(nums, []int{1, -2, 300})
+	floats := []float64{1.5, 2}
+	strs := []string{"a", "世界"}
+	bytes, runes := []byte("hi"), []rune("hi")
+
+	tests := []struct {
+		name  string
+		slice interface{}
+		got   string
+	}{
+		{"ints", nums, SprintOf("test", nums)},
+		{"floats", floats, SprintOf("test", floats)},
+		{"strings", strs, SprintOf("test", strs)},
+		{"bytes", bytes, SprintOf("test", bytes)},
+		{"runes", runes, SprintOf("test", runes)},
+		{"nil", []int(nil), SprintOf[int]("test", nil)},
+	}
+	for _, tt := range tests {
+		if want := Sprint("test", tt.slice); tt.got != want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, tt.got, want)
+		}
+	}
+}
+
+func BenchmarkSprint(b *testing.B) {
+	nums := make([]int, 100)
+
+	b.Run("reflect", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Sprint("nums", nums)
+		}
+	})
+	b.Run("typed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			SprintOf("nums", nums)
+		}
+	})
+}
+
+// BenchmarkValues formats the elements without drawing them
+func BenchmarkValues(b *testing.B) {
+	nums := make([]float64, 100)
+	for i := range nums {
+		nums[i] = float64(i) / 3
+	}
+	p := NewPrinter()
+
+	b.Run("reflect", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d := p.create(nums, nil)
+			for k := range nums {
+				d.value(k)
+			}
+		}
+	})
+	b.Run("typed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			valuesOf(p, nums)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package prettyslice
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	fcolor "github.com/fatih/color"
+)
+
+// HTML draws slices as an html <pre> block with css colors.
+//
+// It draws the same boxes that Show draws.
+// Each element carries a tooltip if Tooltips is true.
+func HTML(msg string, slices ...interface{}) string {
+	return global().HTML(msg, slices...)
+}
+
+// HTML draws slices as an html <pre> block with css colors.
+func (p *Printer) HTML(msg string, slices ...interface{}) string {
+	p, slices = p.withOptions(slices)
+	p = p.colored()
+	buf := new(strings.Builder)
+
+	fmt.Fprintf(buf, `<pre class="prettyslice" style="%s">`, css(ansiForeground, ansiBackground, false))
+
+	for i, slice := range slices {
+		d := p.create(slice, buf)
+		d.html = true
+
+		// only draw the message for the first item (grouping)
+		if i > 0 {
+			msg = ""
+		}
+		d.draw(msg)
+	}
+
+	buf.WriteString("</pre>\n")
+	return buf.String()
+}
+
+// span wraps the string with an html span in the color's style.
+// The color should be enabled to have a style.
+func span(c *fcolor.Color, s, title string) string {
+	var style string
+
+	// the sample contains the escape codes of the color
+	for _, l := range parseANSI(c.Sprint("x")) {
+		for _, cell := range l {
+			fg := cell.fg
+			if fg == ansiForeground {
+				// inherit the foreground color of the <pre> block
+				fg = nil
+			}
+			style = css(fg, cell.bg, cell.bold)
+		}
+	}
+
+	var attrs string
+	if style != "" {
+		attrs += ` style="` + style + `"`
+	}
+	if title != "" {
+		attrs += ` title="` + escapeHTML(title) + `"`
+	}
+	return "<span" + attrs + ">" + escapeHTML(s) + "</span>"
+}
+
+// css returns the css style of the colors
+func css(fg, bg color.Color, bold bool) string {
+	var style []string
+
+	if fg != nil {
+		style = append(style, "color:"+hex(fg))
+	}
+	if bg != nil {
+		style = append(style, "background:"+hex(bg))
+	}
+	if bold {
+		style = append(style, "font-weight:bold")
+	}
+	return strings.Join(style, ";")
+}
+
+// hex returns the css hex code of a color
+func hex(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
+// tooltip returns the index, the type and the value of an element
+func (d drawing) tooltip(index int) string {
+	v := d.backer.Index(index)
+
+	return fmt.Sprintf("index: %d\ntype: %s\nvalue: %#v", index, v.Type(), v)
+}
@@ -0,0 +1,29 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestHTMLColors(t *testing.T) {
+	nc := color.NoColor
+	defer func() { color.NoColor = nc }()
+
+	// the standard output is not a terminal
+	color.NoColor = true
+
+	if out := NewPrinter().HTML("nums", []int{1}); !strings.Contains(out, "<span style=") {
+		t.Errorf("got:\n%s\nwant the colors", out)
+	}
+	if !color.NoColor {
+		t.Error("the color package's setting has changed")
+	}
+
+	p := NewPrinter()
+	WithColors(false)(p)
+	if out := p.HTML("nums", []int{1}); strings.Contains(out, "<span style=") {
+		t.Errorf("got:\n%s\nwant no colors", out)
+	}
+}
@@ -0,0 +1,249 @@
+package prettyslice
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// inspectHelp is drawn at the bottom of the Inspect screen
+const inspectHelp = "←/→ scroll  ↑/↓ page  home/end  b backing  x hex  g jump  tab next  q quit"
+
+// Inspect opens an interactive viewer on the terminal to explore slices
+// that don't fit on the screen, like big buffers.
+//
+// It draws a page of elements that fits on the screen. The arrow keys
+// scroll the elements: left and right by one, up and down by a page.
+// b shows or hides the backing array, x switches the elements to the
+// hexadecimals and back, g jumps to the index that is typed next, tab
+// switches to the next slice, and q quits.
+//
+// It returns an error if the standard input is not a terminal.
+func Inspect(slices ...interface{}) error {
+	return global().Inspect(slices...)
+}
+
+// Inspect opens an interactive viewer on the terminal to explore slices
+// that don't fit on the screen, like big buffers.
+func (p *Printer) Inspect(slices ...interface{}) error {
+	p, slices = p.withOptions(slices)
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return errors.New("prettyslice: Inspect needs a terminal")
+	}
+	if len(slices) == 0 {
+		return nil
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+
+	// the settings change while inspecting: the Printer's don't
+	ip := *p
+	ip.MaxPerLine, ip.FitWidth, ip.Orientation = 0, 0, Horizontal
+	ip.MaxElements, ip.Head, ip.Tail = 0, 0, 0
+
+	in := &inspector{p: &ip, offs: make([]int, len(slices))}
+	for _, s := range slices {
+		// the buffer is set on each page
+		in.ds = append(in.ds, ip.create(s, nil))
+	}
+
+	// draw on the alternate screen without the cursor, and restore them afterwards
+	write(ip.Writer, "\x1b[?1049h\x1b[?25l")
+	defer write(ip.Writer, "\x1b[?25h\x1b[?1049l")
+
+	key := make([]byte, 8)
+	for {
+		w, h, err := term.GetSize(fd)
+		if err != nil {
+			return err
+		}
+		write(ip.Writer, in.screen(w, h))
+
+		n, err := os.Stdin.Read(key)
+		if err != nil {
+			return err
+		}
+		if !in.pressAll(string(key[:n])) {
+			return nil
+		}
+	}
+}
+
+// inspector is the state of Inspect
+type inspector struct {
+	p *Printer
+
+	// ds are the drawings of the slices, and offs are the indexes
+	// of their first elements on the screen
+	ds   []drawing
+	offs []int
+
+	// cur is the slice on the screen
+	cur int
+
+	// page is the number of the elements on the screen
+	page int
+
+	// jump is the index that is being typed after g
+	jump    string
+	jumping bool
+}
+
+// pressAll handles the keys that are read at once, like the pasted ones.
+// An escape sequence is a single key, like an arrow key.
+// It returns false to quit.
+func (in *inspector) pressAll(keys string) bool {
+	if strings.HasPrefix(keys, "\x1b") {
+		return in.press(keys)
+	}
+	for _, k := range keys {
+		if !in.press(string(k)) {
+			return false
+		}
+	}
+	return true
+}
+
+// press handles a key. It returns false to quit.
+func (in *inspector) press(key string) bool {
+	if in.jumping {
+		in.typeJump(key)
+		return true
+	}
+
+	off := &in.offs[in.cur]
+
+	switch key {
+	case "q", "\x03": // ctrl+c
+		return false
+	case "\x1b[C", "l":
+		*off++
+	case "\x1b[D", "h":
+		*off--
+	case "\x1b[B", "\x1b[6~", " ", "j":
+		*off += in.page
+	case "\x1b[A", "\x1b[5~", "k":
+		*off -= in.page
+	case "\x1b[H", "\x1b[1~":
+		*off = 0
+	case "\x1b[F", "\x1b[4~":
+		*off = in.total() - in.page
+	case "b":
+		in.p.PrintBacking = !in.p.PrintBacking
+	case "x":
+		if in.p.ElementFormat == ElementHex {
+			in.p.ElementFormat = ""
+		} else {
+			in.p.ElementFormat = ElementHex
+		}
+	case "g":
+		in.jump, in.jumping = "", true
+	case "\t":
+		in.cur = (in.cur + 1) % len(in.ds)
+	}
+	return true
+}
+
+// typeJump handles a key while the index to jump is being typed
+func (in *inspector) typeJump(key string) {
+	switch {
+	case key == "\r":
+		if i, err := strconv.Atoi(in.jump); err == nil {
+			in.offs[in.cur] = i
+		}
+		in.jumping = false
+	case key == "\x1b", key == "\x03":
+		in.jumping = false
+	case key == "\x7f" && in.jump != "": // backspace
+		in.jump = in.jump[:len(in.jump)-1]
+	case len(key) == 1 && key[0] >= '0' && key[0] <= '9':
+		in.jump += key
+	}
+}
+
+// total returns the number of the elements to explore in the current slice
+func (in *inspector) total() int {
+	return in.ds[in.cur].length()
+}
+
+// screen draws the page of the current slice that fits into a w×h screen
+func (in *inspector) screen(w, h int) string {
+	in.p.lineWidth = w
+
+	// keep the page in the elements
+	off := &in.offs[in.cur]
+	*off = max(min(*off, in.total()-1), 0)
+
+	// draw as many lines as the screen fits: a line has
+	// as many elements as the terminal width fits.
+	// -3 is for the header, the status and the help lines.
+	var out string
+	for lines := max((h-3)/4, 1); lines > 0; lines-- {
+		out = in.draw(*off, lines)
+		if strings.Count(out, "\n") <= h-3 {
+			break
+		}
+	}
+
+	status := fmt.Sprintf(" slice %d/%d  [%d, %d) of %d", in.cur+1, len(in.ds), *off, *off+in.page, in.total())
+	if in.jumping {
+		status = " jump to: " + in.jump + "_"
+	}
+	out += "\n" + status + "\n" + in.p.ColorIndex.Sprint(" "+inspectHelp)
+
+	// the raw terminal doesn't return to the start of the lines
+	return "\x1b[H\x1b[2J" + strings.ReplaceAll(out, "\n", "\r\n")
+}
+
+// draw draws the lines of elements from the off-th element.
+// It sets the page to the number of the elements that are drawn.
+func (in *inspector) draw(off, lines int) string {
+	buf := new(strings.Builder)
+
+	d := in.ds[in.cur]
+	d.buf = buf
+
+	// only look for the elements that can fit on the lines
+	l := min(d.length(), off+lines*d.lineWidth/5)
+
+	// find out where the lines end
+	end := off
+	wd := d.window(off, l)
+	for k := 0; k < lines && end < l; k++ {
+		end = off + wd.lineEnd(end-off, l-off, l-off)
+	}
+	in.page = end - off
+
+	d.header(fmt.Sprintf("#%d", in.cur+1))
+	d.pushNewline()
+
+	if end <= off {
+		d.body()
+		return buf.String()
+	}
+	wd = d.window(off, end)
+	wd.buf = buf
+	wd.body()
+	return buf.String()
+}
+
+// window returns the drawing of the [from, to) elements of a drawing.
+// They're drawn at their indexes: their highlights, labels, widths and
+// addresses are the same.
+func (d drawing) window(from, to int) drawing {
+	w := d
+	w.cols = make([]int, to-from)
+	for i := range w.cols {
+		w.cols[i] = from + i
+	}
+	return w
+}
@@ -0,0 +1,37 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestInspectWindow(t *testing.T) {
+	p := NewPrinter()
+	WithColors(false)(p)
+	p.PrintBacking = false
+	p.Labels = map[int]string{6: "i"}
+	p.Formatter = func(index int, v reflect.Value) (string, bool) {
+		return "v" + strconv.Itoa(index), true
+	}
+
+	in := &inspector{p: p, offs: make([]int, 1)}
+	in.ds = append(in.ds, p.create(make([]int, 10), nil))
+
+	p.lineWidth = 80
+	out := in.draw(5, 1)
+
+	// the elements are drawn at their indexes in the slice
+	for _, want := range []string{"║ v5 ║║ v6 ║", "i"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got:\n%s\nwant %q", out, want)
+		}
+	}
+	if words, _ := columns(strings.Split(out, "\n")[4]); words[0] != "5" {
+		t.Errorf("got the indexes %q, want them to start from 5", words)
+	}
+	if strings.Contains(out, "v0") {
+		t.Errorf("got:\n%s\nwant no window relative indexes", out)
+	}
+}
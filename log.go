@@ -0,0 +1,146 @@
+package prettyslice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// LogValue returns a value for the structured logs that draws the slice
+// when it's logged, like:
+//
+//	slog.Info("sorted", "nums", prettyslice.LogValue(nums))
+//
+// The slice is drawn without colors and with the ascii characters,
+// so it can be read in any log stream. However, the text and the json
+// handlers quote the drawing on a single line. LogHandler writes it
+// as it is below the log line.
+func LogValue(slice interface{}) slog.LogValuer {
+	return global().LogValue(slice)
+}
+
+// LogValue returns a value for the structured logs that draws the slice
+// when it's logged.
+func (p *Printer) LogValue(slice interface{}) slog.LogValuer {
+	lp := *p
+	lp.Style, lp.Width = StyleASCII, 0
+
+	return logValue{p: &lp, slice: slice}
+}
+
+// logValue draws a slice when it's logged
+type logValue struct {
+	p     *Printer
+	slice interface{}
+}
+
+// LogValue draws the slice without the colors
+func (v logValue) LogValue() slog.Value {
+	return slog.StringValue(v.draw(""))
+}
+
+// draw draws the slice without the colors
+func (v logValue) draw(msg string) string {
+	return stripANSI(v.p.text(msg, v.slice))
+}
+
+// LogHandler wraps a log handler to draw the slices of the log records,
+// like:
+//
+//	slog.SetDefault(slog.New(prettyslice.LogHandler(slog.NewTextHandler(os.Stderr, nil), os.Stderr)))
+//	slog.Info("sorted", "nums", nums)
+//
+// The handler logs the records with the slices' types instead of the
+// slices. Then, it draws the slices to w below the log line, like LogValue
+// draws them. The slices of the handler's own attributes are not drawn.
+func LogHandler(h slog.Handler, w io.Writer) slog.Handler {
+	return global().LogHandler(h, w)
+}
+
+// LogHandler wraps a log handler to draw the slices of the log records.
+func (p *Printer) LogHandler(h slog.Handler, w io.Writer) slog.Handler {
+	return logHandler{Handler: h, p: p, w: w}
+}
+
+// logHandler draws the slices of the log records below their lines
+type logHandler struct {
+	slog.Handler
+
+	p *Printer
+	w io.Writer
+}
+
+// Handle logs the record, and draws its slices
+func (h logHandler) Handle(ctx context.Context, r slog.Record) error {
+	var (
+		names  []string
+		values []logValue
+	)
+
+	nr := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		if v, ok := h.logValue(a.Value); ok {
+			names, values = append(names, a.Key), append(values, v)
+			a.Value = slog.StringValue(fmt.Sprintf("%T (drawn below)", v.slice))
+		}
+		nr.AddAttrs(a)
+		return true
+	})
+
+	if err := h.Handler.Handle(ctx, nr); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	for i, v := range values {
+		out.WriteString(v.draw(names[i]))
+	}
+	if out.Len() == 0 {
+		return nil
+	}
+	return write(h.w, out.String())
+}
+
+// logValue returns the drawing of a log value if it's a slice, an array or a map
+func (h logHandler) logValue(v slog.Value) (logValue, bool) {
+	switch v.Kind() {
+	case slog.KindLogValuer:
+		lv, ok := v.LogValuer().(logValue)
+		return lv, ok
+	case slog.KindAny:
+		switch reflect.ValueOf(v.Any()).Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return h.p.LogValue(v.Any()).(logValue), true
+		}
+	}
+	return logValue{}, false
+}
+
+// WithAttrs returns a handler with the attributes that draws the slices
+func (h logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h.Handler = h.Handler.WithAttrs(attrs)
+	return h
+}
+
+// WithGroup returns a handler with the group that draws the slices
+func (h logHandler) WithGroup(name string) slog.Handler {
+	h.Handler = h.Handler.WithGroup(name)
+	return h
+}
+
+// stripANSI removes the color codes from a drawing
+func stripANSI(s string) string {
+	var buf strings.Builder
+	for i, l := range parseANSI(s) {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		for _, c := range l {
+			buf.WriteRune(c.r)
+		}
+	}
+	return buf.String()
+}
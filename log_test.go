@@ -0,0 +1,41 @@
+package prettyslice
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogHandler(t *testing.T) {
+	var buf strings.Builder
+	log := slog.New(LogHandler(slog.NewTextHandler(&buf, nil), &buf))
+
+	log.Info("sorted", "nums", []int{1, 2}, "n", 3)
+
+	lines := strings.Split(buf.String(), "\n")
+	if want := `nums="[]int (drawn below)" n=3`; !strings.Contains(lines[0], want) {
+		t.Errorf("got the log line %q, want %q", lines[0], want)
+	}
+
+	// the drawing is below the log line as it is
+	for _, want := range []string{"+---++---+", "| 1 || 2 |"} {
+		if !strings.Contains(buf.String(), "\n"+want+"\n") {
+			t.Errorf("got:\n%s\nwant the line %q", buf.String(), want)
+		}
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf strings.Builder
+	log := slog.New(slog.NewTextHandler(&buf, nil))
+
+	log.Info("sorted", "nums", LogValue([]int{1, 2}))
+
+	// the text handler quotes the drawing
+	if want := `nums="   (len:2`; !strings.Contains(buf.String(), want) {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+	if !strings.Contains(buf.String(), `| 1 || 2 |`) {
+		t.Errorf("got %q, want the elements", buf.String())
+	}
+}
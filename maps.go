@@ -0,0 +1,47 @@
+package prettyslice
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// mapValues returns the values of a map as a slice, and their keys.
+// The keys are sorted if SortKeys is true.
+func (p *Printer) mapValues(m reflect.Value) (reflect.Value, []string) {
+	t := reflect.SliceOf(m.Type().Elem())
+	if m.IsNil() {
+		return reflect.Zero(t), []string{}
+	}
+
+	keys := m.MapKeys()
+	if p.SortKeys {
+		sort.Slice(keys, func(i, j int) bool {
+			return keyLess(keys[i], keys[j])
+		})
+	}
+
+	values := reflect.MakeSlice(t, len(keys), len(keys))
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		values.Index(i).Set(m.MapIndex(k))
+		labels[i] = fmt.Sprintf("%v", k)
+	}
+	return values, labels
+}
+
+// keyLess compares two map keys: the numbers by their values,
+// the others by how they're printed.
+func keyLess(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return fmt.Sprintf("%v", a) < fmt.Sprintf("%v", b)
+}
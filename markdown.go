@@ -0,0 +1,119 @@
+package prettyslice
+
+import (
+	"strings"
+)
+
+// Markdown draws slices as GitHub flavored Markdown tables.
+//
+// The table has a row for the index numbers and a row for the slice
+// elements. The backing array's elements are put into a separate row
+// in italics if PrintBacking is true.
+//
+// Each slice is wrapped in a collapsible details block if Collapsible is true.
+func Markdown(msg string, slices ...interface{}) string {
+	return global().Markdown(msg, slices...)
+}
+
+// Markdown draws slices as GitHub flavored Markdown tables.
+func (p *Printer) Markdown(msg string, slices ...interface{}) string {
+	p, slices = p.withOptions(slices)
+	buf := new(strings.Builder)
+
+	for i, slice := range slices {
+		d := p.create(slice, buf)
+
+		// only draw the message for the first item (grouping)
+		if i > 0 {
+			msg = ""
+			d.pushNewline()
+		}
+		d.markdown(msg)
+	}
+	return buf.String()
+}
+
+// markdown draws the slice as a Markdown table
+func (d drawing) markdown(msg string) {
+	// squeeze the paddings of the header info
+	info := strings.Join(strings.Fields(d.info()), " ")
+	info = strings.Replace(info, " )", ")", 1)
+
+	title := strings.TrimSpace(msg + " " + info)
+
+	if d.Collapsible {
+		d.push("<details>\n")
+		d.push("<summary>" + escapeHTML(title) + "</summary>\n\n")
+	} else if title != "" {
+		d.push("**" + escapeMarkdown(title) + "**\n\n")
+	}
+
+	d.table()
+
+	if d.Collapsible {
+		d.push("\n</details>\n")
+	}
+}
+
+// table draws the index, slice and backing array rows of the Markdown table
+func (d drawing) table() {
+	if s := d.slice; s.IsNil() {
+		d.push("_" + escapeHTML(d.nilText()) + "_\n")
+		return
+	} else if s.Len() == 0 && (!d.PrintBacking || s.Cap() == 0) {
+		d.push("_" + escapeHTML(d.emptyText()) + "_\n")
+		return
+	}
+
+	l := d.length()
+	d.cols = d.columns(l)
+
+	var indexes, slice, backer, sep []string
+	for k := 0; k < d.ncols(l); k++ {
+		sep = append(sep, "---")
+
+		// the gap of the elided elements
+		i := d.col(k)
+		if i < 0 {
+			indexes = append(indexes, "…")
+			slice, backer = append(slice, "…"), append(backer, "…")
+			continue
+		}
+
+		indexes = append(indexes, escapeMarkdown(d.label(i)))
+
+		v := escapeMarkdown(d.value(i))
+		if d.backing(i) {
+			// the backing array's elements are in italics
+			if v != "" {
+				v = "_" + v + "_"
+			}
+			slice, backer = append(slice, ""), append(backer, v)
+		} else {
+			slice, backer = append(slice, v), append(backer, "")
+		}
+	}
+
+	row := func(name string, cells []string) {
+		d.push("| " + name + " | " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	row("index", indexes)
+	row("---", sep)
+	row("slice", slice)
+	if l > d.slice.Len() {
+		row("backing", backer)
+	}
+}
+
+// escapeMarkdown escapes the characters that break the Markdown tables,
+// and the ones that are rendered as the HTML tags, like: <nil>
+var escapeMarkdown = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`",
+	"&", "&amp;", "<", "&lt;", ">", "&gt;",
+).Replace
+
+// escapeHTML escapes the characters that break the HTML tags
+var escapeHTML = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&#39;",
+).Replace
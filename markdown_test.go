@@ -0,0 +1,19 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownEscapes(t *testing.T) {
+	out := NewPrinter().Markdown("values", []interface{}{nil, "a|b", "<b>"})
+
+	for _, want := range []string{`&lt;nil&gt;`, `a\|b`, `&lt;b&gt;`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got:\n%s\nwant %q", out, want)
+		}
+	}
+	if strings.Contains(out, "<nil>") || strings.Contains(out, "<b>") {
+		t.Errorf("got:\n%s\nwant no html tags", out)
+	}
+}
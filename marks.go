@@ -0,0 +1,75 @@
+package prettyslice
+
+import (
+	"github.com/fatih/color"
+)
+
+// ShowWith pretty prints a slice with the options only for this call.
+//
+// For example, it can point at the positions of an algorithm:
+//
+//	ShowWith("sort", nums, Mark(i, j), Label(i, "i"), Label(j, "j"))
+func ShowWith(msg string, slice interface{}, opts ...Option) {
+	global().ShowWith(msg, slice, opts...)
+}
+
+// ShowWith pretty prints a slice with the options only for this call.
+// The options don't change the Printer.
+func (p *Printer) ShowWith(msg string, slice interface{}, opts ...Option) {
+	wp := *p
+	for _, opt := range opts {
+		opt(&wp)
+	}
+	wp.Show(msg, slice)
+}
+
+// Mark draws the elements at the indexes in ColorMark
+func Mark(indexes ...int) Option {
+	return func(p *Printer) {
+		h := make(map[int]*color.Color, len(p.Highlight)+len(indexes))
+		for i, c := range p.Highlight {
+			h[i] = c
+		}
+		for _, i := range indexes {
+			h[i] = p.ColorMark
+		}
+		p.Highlight = h
+	}
+}
+
+// Label draws a label with an arrow below the element at the index
+func Label(index int, label string) Option {
+	return func(p *Printer) {
+		l := make(map[int]string, len(p.Labels)+1)
+		for i, s := range p.Labels {
+			l[i] = s
+		}
+		if s, ok := l[index]; ok {
+			label = s + "," + label
+		}
+		l[index] = label
+		p.Labels = l
+	}
+}
+
+// labelNotes adds the labels to the notes of the slice elements
+func (d drawing) labelNotes() []string {
+	notes := make([]string, d.slice.Len())
+	copy(notes, d.notes)
+
+	arrow := d.Style.chars().arrow
+	if d.Orientation == Vertical {
+		arrow = d.Style.chars().arrowLeft
+	}
+
+	for i, l := range d.Labels {
+		if i < 0 || i >= len(notes) {
+			continue
+		}
+		if notes[i] != "" {
+			notes[i] += " "
+		}
+		notes[i] += arrow + l
+	}
+	return notes
+}
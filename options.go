@@ -1,6 +1,9 @@
 package prettyslice
 
 import (
+	"reflect"
+	"time"
+
 	"github.com/fatih/color"
 )
 
@@ -17,23 +20,101 @@ var (
 	// ColorBacker sets the color for the backing array's items
 	ColorBacker = color.New(color.FgHiBlack)
 
+	// ColorFill sets the color for the filled parts of the boxes
+	// (only if FillByValue is true)
+	ColorFill = color.New(color.BgCyan, color.FgBlack)
+
+	// ColorDiff sets the color for the changed elements
+	// (only for ShowDiff)
+	ColorDiff = color.New(color.FgYellow, color.Bold)
+
+	// ColorInsert sets the color for the inserted elements
+	// (only for ShowDiff)
+	ColorInsert = color.New(color.FgGreen, color.Bold)
+
+	// ColorMark sets the color for the marked elements
+	// (only for ShowWith and Mark)
+	ColorMark = color.New(color.FgRed, color.Bold)
+
 	// ColorIndex sets the color for the index numbers of the elements
 	ColorIndex = ColorBacker
 
 	// ColorAddr sets the color for the element addresses
 	ColorAddr = ColorBacker
 
+	// AutoColors draws without the colors when the NO_COLOR environment
+	// variable is set, or when the Writer is not a terminal, like a file.
+	//
+	// Sprint and the other outputs that return the drawings don't check it.
+	AutoColors = true
+
+	// Highlight draws the elements at its indexes in its colors.
+	//
+	// The indexes are the indexes of the elements in the slice,
+	// the backing array's elements can be highlighted as well.
+	Highlight map[int]*color.Color
+
+	// Labels draws its labels with arrows below the elements at its indexes
+	Labels map[int]string
+
 	// MaxPerLine is maximum number of slice items on a line
 	MaxPerLine = 5
 
+	// AutoWidth wraps the lines at the width of the terminal
+	// when the Writer is a terminal.
+	//
+	// The width is checked each time the slices are drawn.
+	// MaxPerLine still limits the number of items on a line,
+	// set it to 0 to draw as many items as the terminal fits.
+	AutoWidth = true
+
+	// FitWidth squeezes all the elements onto a single line that is
+	// exactly FitWidth columns wide.
+	//
+	// Box widths are distributed evenly, element values that don't fit
+	// into their boxes are truncated with "…", and short ones are padded.
+	//
+	// 0 means disabled. It overrides MaxPerLine when it's enabled.
+	FitWidth = 0
+
 	// MaxElements limits the number of elements printed
 	// 0 means print all the elements.
+	//
+	// When there are more elements, the first and the last halves of them
+	// are printed with a "… 42 more …" box in between.
+	// Their index numbers are real.
 	MaxElements = 0
 
+	// Head and Tail limit the number of elements printed like MaxElements,
+	// but the first Head and the last Tail elements are printed.
+	// They override MaxElements when one of them is not 0.
+	Head, Tail = 0, 0
+
 	// Width is the width of the header
 	// It will separate the header message and the slice details with empty spaces
 	Width = 45
 
+	// FillByValue fills the boxes of numeric elements like gauges.
+	//
+	// Each box is filled in proportion to its element's value, scaled
+	// between the smallest and the biggest elements of the slice.
+	// When all the elements are the same, all the boxes are filled.
+	FillByValue = false
+
+	// StructFields draws the fields of the struct elements separately.
+	//
+	// Each exported field gets a column in the element's box,
+	// with the field's name above its value.
+	StructFields = false
+
+	// IncludeFields draws only the struct fields with these names
+	// (only if StructFields is true)
+	IncludeFields []string
+
+	// ExcludeFields doesn't draw the struct fields with these names
+	// (only if StructFields is true)
+	ExcludeFields []string
+
 	// PrettyByteRune prints byte and rune elements as chars
 	PrettyByteRune = true
 
@@ -43,6 +124,11 @@ var (
 	// PrintElementAddr prints the addresses of each element
 	PrintElementAddr = false
 
+	// PrintOffsets prints the byte offsets of the elements from the slice's
+	// pointer instead of their addresses, like: +0 +8 +16.
+	// (only if PrintElementAddr is true)
+	PrintOffsets = false
+
 	// PrintHex prints the pointers in hexadecimals
 	//
 	// When it's false, only the last 4 digits of the pointers will be printed as decimals.
@@ -50,9 +136,98 @@ var (
 	// When it's true, all the digits of the pointers will be printed as hexadecimals.
 	PrintHex = false
 
+	// RawPointer prints the real pointer of the slice in the header
+	// as hexadecimals, like: ptr:0xc000012345
+	//
+	// The pointers are simplified by default and two different pointers
+	// can look the same, so they can't tell whether two slices share the same
+	// backing array. The real pointers are never the same.
+	//
+	// It prints the real addresses of the elements as well
+	// (only if PrintElementAddr is true)
+	RawPointer = false
+
+	// PointerFormat controls how to print the pointers.
+	//
+	// PointerAuto prints them as PrintHex, RawPointer and NormalizePointers say.
+	PointerFormat = PointerAuto
+
+	// ShowHeaderAddr prints the address of the slice header in the header,
+	// like: hdr:0xc000010018. It's printed only if a pointer to the slice
+	// is drawn, like: Show("nums", &nums).
+	//
+	// Unlike the slice's pointer, it's the address of the slice variable:
+	// the slices that share a backing array have different headers.
+	ShowHeaderAddr = false
+
+	// ShowLen prints the length of the slice in the header
+	ShowLen = true
+
+	// ShowCap prints the capacity of the slice in the header
+	ShowCap = true
+
+	// ShowPtr prints the pointer of the slice in the header
+	ShowPtr = true
+
+	// ShowHash prints a short checksum of the slice elements in the header.
+	//
+	// It is computed from the elements as they're drawn,
+	// so the slices that look the same have the same checksum.
+	ShowHash = false
+
+	// ShowElemSize prints the size of an element in bytes in the header.
+	// It's the distance between the addresses of the elements.
+	ShowElemSize = false
+
+	// ShowElemType prints the element type of the slice in the header.
+	//
+	// When the slice comes from a generic function, like a []T,
+	// it prints the concrete type that T is instantiated with.
+	ShowElemType = false
+
+	// SortKeys sorts the keys of a map before drawing its elements.
+	//
+	// The values of a map are drawn in boxes with their keys below them.
+	// When it's false, they're drawn in the random order of the map.
+	SortKeys = true
+
+	// StringAsBytes draws the bytes of a string instead of its runes.
+	//
+	// A string is drawn as a rune slice by default,
+	// so its length is the number of the runes in it.
+	StringAsBytes = false
+
+	// RuneOffsets draws the byte offsets of the runes of a string
+	// instead of their indexes, like a for range loop over the string does.
+	RuneOffsets = false
+
 	// PrintBytesHex prints byte elements as hex digits
 	PrintBytesHex = false
 
+	// PrintASCII draws the ascii characters of the byte elements
+	// below their indexes, and a dot for the bytes that can't be printed.
+	// It's handy with the hex bytes.
+	PrintASCII = false
+
+	// ElementFormat formats the integer elements with a fmt verb, like: "%02x".
+	// It's used when it's not empty, and when Formatter doesn't format them.
+	// The other elements are formatted as usual, like the strings.
+	//
+	// See ElementHex, ElementBinary, ElementDecimal and ElementChar for the presets.
+	ElementFormat = ""
+
+	// BytesAs controls how to print the byte elements.
+	//
+	// ByteAuto prints them as PrettyByteRune and PrintBytesHex say.
+	BytesAs = ByteAuto
+
+	// TerminalWidths measures the elements as the terminals draw them:
+	// the wide characters, like the CJK ones and the emoji, take two columns,
+	// and the combining characters take none.
+	//
+	// Disable it for the outputs that draw each character in one column.
+	TerminalWidths = true
+
 	// SpaceCharacter gets printed when a space character is found.
 	// (only if PrettyByteRune is true)
 	SpaceCharacter = ' '
@@ -74,21 +249,142 @@ var (
 	// So, it basically normalizes by the element type size.
 	NormalizePointers = false
 
+	// Formatter controls how the elements are printed.
+	//
+	// It gets the index of the element in the backing array and the element.
+	// When it returns false, or when it's nil, the element is printed as usual.
+	Formatter func(index int, v reflect.Value) (string, bool)
+
+	// Collapsible wraps each slice in a collapsible details block
+	// (only for the Markdown output)
+	Collapsible = false
+
+	// Tooltips adds a tooltip to each element with its index, type and value
+	// (only for the HTML output)
+	Tooltips = false
+
+	// FrameDelay is the delay between the frames of AnimateAppends
+	FrameDelay = 500 * time.Millisecond
+
+	// Style sets the characters to draw the boxes with.
+	//
+	// StyleUnicode draws them with double lines, StyleRounded with
+	// rounded corners, and StyleASCII only with ascii characters
+	// for the terminals and logs that can't draw the others.
+	Style = StyleUnicode
+
+	// Orientation sets the direction to draw the elements in.
+	//
+	// Horizontal draws them side by side, and Vertical draws them one below
+	// the other: one element per row, with its index on the left. Vertical
+	// suits the long strings and the structs that don't fit on a line.
+	Orientation = Horizontal
+
+	// Format sets the format of the drawings of Show and Sprint.
+	//
+	// FormatText draws them as text with the color codes for the terminals.
+	// FormatHTML draws them as HTML like HTML does, FormatSVG draws them
+	// as SVG images like SVG does, and FormatMarkdown draws them as
+	// Markdown tables like Markdown does.
+	Format = FormatText
+
 	// Writer controls where to draw the slices
 	Writer = color.Output
 )
 
+// ByteMode is a way of printing the byte elements
+type ByteMode int
+
+const (
+	// ByteAuto prints the bytes as PrettyByteRune and PrintBytesHex say
+	ByteAuto ByteMode = iota
+
+	// ByteAsNumber prints the bytes as decimal numbers, like: 10
+	ByteAsNumber
+
+	// ByteAsChar prints the bytes as characters, like: a.
+	// It escapes the bytes that can't be seen, like: \n, \t, \x00.
+	ByteAsChar
+
+	// ByteAsHex prints the bytes as hexadecimals, like: 0x0a
+	ByteAsHex
+
+	// byteAsHexDigits prints the bytes as hex digits, like: 0a
+	// (only for PrintBytesHex)
+	byteAsHexDigits
+)
+
+// PointerMode is a way of printing the pointers
+type PointerMode int
+
+const (
+	// PointerAuto prints the pointers as PrintHex, RawPointer
+	// and NormalizePointers say
+	PointerAuto PointerMode = iota
+
+	// PointerShort prints the last 4 digits of the pointers, like: 2912
+	PointerShort
+
+	// PointerRaw prints the real pointers like %p does, like: 0xc000012345
+	PointerRaw
+
+	// PointerHex prints all the digits of the pointers as hexadecimals,
+	// like: c000012345
+	PointerHex
+
+	// PointerNormalized prints the pointers as if the elements are
+	// contiguous, like NormalizePointers does
+	PointerNormalized
+)
+
+// The presets of ElementFormat
+const (
+	// ElementHex formats the elements as hexadecimals, like: 0a
+	ElementHex = "%02x"
+
+	// ElementBinary formats the elements as binary numbers, like: 00001010
+	ElementBinary = "%08b"
+
+	// ElementDecimal formats the elements as decimal numbers, like: 10
+	ElementDecimal = "%d"
+
+	// ElementChar formats the elements as characters, like: a
+	ElementChar = "%c"
+)
+
+// Direction is a direction to draw the elements in
+type Direction int
+
+const (
+	// Horizontal draws the elements side by side
+	Horizontal Direction = iota
+
+	// Vertical draws the elements one below the other
+	Vertical
+)
+
+// OutputFormat is a format of the drawings
+type OutputFormat int
+
+const (
+	// FormatText draws the slices as text with the color codes
+	FormatText OutputFormat = iota
+
+	// FormatHTML draws the slices as an html <pre> block with css colors
+	FormatHTML
+
+	// FormatSVG draws the slices as an svg image
+	FormatSVG
+
+	// FormatMarkdown draws the slices as GitHub flavored Markdown tables
+	FormatMarkdown
+)
+
+// noColors is true if the colors are disabled with Colors(false)
+var noColors bool
+
 // Colors is used to enable/disable the color data from the output
 func Colors(enabled bool) {
-	colors := []*color.Color{
-		ColorHeader, ColorSlice, ColorBacker, ColorIndex,
-	}
-
-	for _, color := range colors {
-		if enabled {
-			color.EnableColor()
-		} else {
-			color.DisableColor()
-		}
-	}
+	noColors = !enabled
+	global().Colors(enabled)
 }
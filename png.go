@@ -0,0 +1,196 @@
+package prettyslice
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+var (
+	// pngFace is the font for drawing the PNG images
+	pngFace = basicfont.Face7x13
+
+	// pngASCII are the ascii runes that are drawn instead of the runes
+	// that pngFace doesn't have: it only has the ascii characters
+	pngASCII = map[rune]rune{
+		'↑': '^', '↓': 'v', '←': '<', '→': '>', '×': 'x',
+	}
+)
+
+// PNG draws a slice as an image that can be saved with png.Encode.
+//
+// It draws the same boxes that Show draws with the configured colors.
+// The colors are drawn even if the Writer is not a terminal,
+// unless they're disabled with Colors(false).
+func PNG(msg string, slice interface{}) (image.Image, error) {
+	return global().PNG(msg, slice)
+}
+
+// PNG draws a slice as an image that can be saved with png.Encode.
+func (p *Printer) PNG(msg string, slice interface{}) (image.Image, error) {
+	if slice == nil {
+		return nil, errors.New("prettyslice: cannot draw a nil interface")
+	}
+
+	out := p.colored().text(msg, slice)
+
+	return textImage(out, p.TerminalWidths), nil
+}
+
+// textImage draws a colored drawing as an image.
+// The runes are drawn in the terminal columns if terminal is true.
+func textImage(out string, terminal bool) *image.RGBA {
+	lines := parseANSI(strings.TrimSuffix(out, "\n"))
+	if terminal {
+		for i, l := range lines {
+			lines[i] = terminalCells(l)
+		}
+	}
+
+	var cols int
+	for _, l := range lines {
+		if len(l) > cols {
+			cols = len(l)
+		}
+	}
+
+	var (
+		cw = pngFace.Advance
+		ch = pngFace.Height
+	)
+
+	img := image.NewRGBA(image.Rect(0, 0, cols*cw, len(lines)*ch))
+	draw.Draw(img, img.Bounds(), image.NewUniform(ansiBackground), image.Point{}, draw.Src)
+
+	for y, l := range lines {
+		for x, c := range l {
+			cell := image.Rect(x*cw, y*ch, (x+1)*cw, (y+1)*ch)
+			if c.bg != nil {
+				draw.Draw(img, cell, image.NewUniform(c.bg), image.Point{}, draw.Src)
+			}
+
+			// the second cell of a wide rune is empty
+			if c.r == 0 || drawBox(img, cell, c.r, c.fg) {
+				continue
+			}
+
+			r := c.r
+			if a, ok := pngASCII[r]; ok {
+				r = a
+			}
+
+			fd := font.Drawer{
+				Dst:  img,
+				Src:  image.NewUniform(c.fg),
+				Face: pngFace,
+				Dot:  fixed.P(cell.Min.X, cell.Min.Y+pngFace.Ascent),
+			}
+			fd.DrawString(string(r))
+		}
+	}
+	return img
+}
+
+// drawBox draws the box drawing characters and the symbols that the font doesn't have.
+// It returns false if the rune is not one of them.
+func drawBox(img *image.RGBA, cell image.Rectangle, r rune, c color.Color) bool {
+	var (
+		cx = cell.Min.X + cell.Dx()/2
+		cy = cell.Min.Y + cell.Dy()/2
+
+		minX, maxX = cell.Min.X, cell.Max.X - 1
+		minY, maxY = cell.Min.Y, cell.Max.Y - 1
+	)
+
+	hline := func(x0, x1, y int) {
+		for x := x0; x <= x1; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	vline := func(x, y0, y1 int) {
+		for y := y0; y <= y1; y++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	dot := func(x, y, r int) {
+		for dy := -r; dy <= r; dy++ {
+			for dx := -r; dx <= r; dx++ {
+				if dx*dx+dy*dy <= r*r+r {
+					img.Set(x+dx, y+dy, c)
+				}
+			}
+		}
+	}
+
+	switch r {
+	case '─':
+		hline(minX, maxX, cy)
+	case '│':
+		vline(cx, minY, maxY)
+	case '├':
+		vline(cx, minY, maxY)
+		hline(cx, maxX, cy)
+	case '┤':
+		vline(cx, minY, maxY)
+		hline(minX, cx, cy)
+	// the rounded corners skip the pixel at the corner
+	case '╭':
+		hline(cx+1, maxX, cy)
+		vline(cx, cy+1, maxY)
+	case '╮':
+		hline(minX, cx-1, cy)
+		vline(cx, cy+1, maxY)
+	case '╰':
+		hline(cx+1, maxX, cy)
+		vline(cx, minY, cy-1)
+	case '╯':
+		hline(minX, cx-1, cy)
+		vline(cx, minY, cy-1)
+	case '…':
+		y := cell.Min.Y + pngFace.Ascent - 1
+		hline(minX+1, minX+1, y)
+		hline(cx, cx, y)
+		hline(maxX-1, maxX-1, y)
+	case '·':
+		hline(cx, cx+1, cy)
+		hline(cx, cx+1, cy+1)
+	case '●':
+		dot(cx, cy, 3)
+	case '═':
+		hline(minX, maxX, cy-1)
+		hline(minX, maxX, cy+1)
+	case '║':
+		vline(cx-1, minY, maxY)
+		vline(cx+1, minY, maxY)
+	case '╔':
+		hline(cx-1, maxX, cy-1)
+		hline(cx+1, maxX, cy+1)
+		vline(cx-1, cy-1, maxY)
+		vline(cx+1, cy+1, maxY)
+	case '╗':
+		hline(minX, cx+1, cy-1)
+		hline(minX, cx-1, cy+1)
+		vline(cx+1, cy-1, maxY)
+		vline(cx-1, cy+1, maxY)
+	case '╚':
+		hline(cx-1, maxX, cy+1)
+		hline(cx+1, maxX, cy-1)
+		vline(cx-1, minY, cy+1)
+		vline(cx+1, minY, cy-1)
+	case '╝':
+		hline(minX, cx+1, cy+1)
+		hline(minX, cx-1, cy-1)
+		vline(cx+1, minY, cy+1)
+		vline(cx-1, minY, cy-1)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package prettyslice
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestPNGRunes(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, pngFace.Advance, pngFace.Height))
+
+	for style, cs := range charsets {
+		v := reflect.ValueOf(cs)
+		for i := 0; i < v.NumField(); i++ {
+			for _, r := range v.Field(i).String() {
+				_, inFont := pngFace.GlyphAdvance(r)
+				_, ascii := pngASCII[r]
+
+				if !inFont && !ascii && !drawBox(img, img.Bounds(), r, color.White) {
+					t.Errorf("style %d: can't draw %q", style, r)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package prettyslicetest draws the slices that are not equal in the tests.
+package prettyslicetest
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/inancgumus/prettyslice"
+)
+
+// Equal fails the test if the slices are not deeply equal, and draws them
+// one below the other with their differences.
+//
+// The changed elements are marked with a "^" below them, the extra elements
+// are marked with a "-" when they're only in the want, and with a "+" when
+// they're only in the got. It returns true if the slices are equal.
+func Equal(t testing.TB, want, got interface{}) bool {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return true
+	}
+
+	buf := new(strings.Builder)
+
+	p := prettyslice.New(prettyslice.WithWriter(buf), prettyslice.WithColors(false))
+	p.Style = prettyslice.StyleASCII
+	p.Width = 0
+	p.ShowPtr = false
+	p.Labels = changed(want, got)
+
+	p.ShowDiff("want -> got", want, got)
+
+	t.Errorf("slices are not equal: before is the want, after is the got\n%s", buf)
+	return false
+}
+
+// changed returns the indexes of the elements that are not equal in
+// the both slices, with empty labels.
+func changed(want, got interface{}) map[int]string {
+	w, g := reflect.ValueOf(want), reflect.ValueOf(got)
+	if !indexable(w) || !indexable(g) {
+		return nil
+	}
+
+	labels := make(map[int]string)
+	for i := 0; i < w.Len() && i < g.Len(); i++ {
+		if !reflect.DeepEqual(w.Index(i).Interface(), g.Index(i).Interface()) {
+			labels[i] = ""
+		}
+	}
+	return labels
+}
+
+// indexable is true if the value has elements that can be compared
+func indexable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package prettyslicetest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// fakeTB records the failures of a test
+type fakeTB struct {
+	testing.TB
+
+	failed bool
+	msg    string
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...interface{}) {
+	t.failed = true
+	t.msg += fmt.Sprintf(format, args...)
+}
+
+func TestEqual(t *testing.T) {
+	ft := &fakeTB{}
+	if !Equal(ft, []int{1, 2}, []int{1, 2}) || ft.failed {
+		t.Errorf("got a failure for the equal slices: %s", ft.msg)
+	}
+}
+
+func TestNotEqual(t *testing.T) {
+	ft := &fakeTB{}
+	if Equal(ft, []int{1, 2, 3}, []int{1, 5}) || !ft.failed {
+		t.Fatal("got no failure for the slices that are not equal")
+	}
+
+	for _, want := range []string{"slices are not equal", "want -> got", "| 5 |", "^"} {
+		if !strings.Contains(ft.msg, want) {
+			t.Errorf("got:\n%s\nwant %q", ft.msg, want)
+		}
+	}
+
+	// the logs of the CI can't draw the non-ascii runes
+	for _, r := range ft.msg {
+		if r > 0x7f {
+			t.Errorf("got the non-ascii rune %q in:\n%s", r, ft.msg)
+			break
+		}
+	}
+}
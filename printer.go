@@ -0,0 +1,330 @@
+package prettyslice
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// Printer pretty prints slices with its own settings.
+//
+// Its fields are the same as the package-level settings,
+// see them for the details. Unlike them, a Printer can be
+// configured without affecting the other Printers.
+//
+// A Printer can be used from multiple goroutines, as long as its settings
+// don't change meanwhile. Each drawing is written at once: the concurrent
+// drawings never interleave.
+type Printer struct {
+	// colors
+	ColorHeader *color.Color
+	ColorSlice  *color.Color
+	ColorBacker *color.Color
+	ColorFill   *color.Color
+	ColorDiff   *color.Color
+	ColorInsert *color.Color
+	ColorMark   *color.Color
+	ColorIndex  *color.Color
+	ColorAddr   *color.Color
+	AutoColors  bool
+	Highlight   map[int]*color.Color
+	Labels      map[int]string
+
+	// layout
+	MaxPerLine  int
+	AutoWidth   bool
+	FitWidth    int
+	MaxElements int
+	Head        int
+	Tail        int
+	Width       int
+	Style       BoxStyle
+	Orientation Direction
+	FillByValue bool
+
+	// elements
+	StructFields      bool
+	IncludeFields     []string
+	ExcludeFields     []string
+	PrettyByteRune    bool
+	PrintBacking      bool
+	PrintElementAddr  bool
+	PrintOffsets      bool
+	PrintHex          bool
+	RawPointer        bool
+	PointerFormat     PointerMode
+	ShowHeaderAddr    bool
+	ShowLen           bool
+	ShowCap           bool
+	ShowPtr           bool
+	ShowHash          bool
+	ShowElemSize      bool
+	ShowElemType      bool
+	SortKeys          bool
+	StringAsBytes     bool
+	RuneOffsets       bool
+	PrintBytesHex     bool
+	PrintASCII        bool
+	ElementFormat     string
+	BytesAs           ByteMode
+	TerminalWidths    bool
+	SpaceCharacter    rune
+	NormalizePointers bool
+	Formatter         func(index int, v reflect.Value) (string, bool)
+
+	// other outputs
+	Collapsible bool
+	Tooltips    bool
+	FrameDelay  time.Duration
+	Format      OutputFormat
+
+	// Writer controls where to draw the slices
+	Writer io.Writer
+
+	// lineWidth is the width of the terminal to draw the lines in
+	// (only if AutoWidth is true)
+	lineWidth int
+
+	// values are the formatted elements of the typed slices of SprintOf
+	values [][]string
+
+	// noColors is true if the colors are disabled with Colors(false).
+	// They're not drawn even in the images.
+	noColors bool
+}
+
+// NewPrinter creates a new Printer with the default settings
+func NewPrinter() *Printer {
+	backer := color.New(color.FgHiBlack)
+
+	return &Printer{
+		ColorHeader: color.New(
+			color.BgHiBlack,
+			color.FgMagenta,
+			color.Bold),
+		ColorSlice:  color.New(color.FgCyan),
+		ColorBacker: backer,
+		ColorFill:   color.New(color.BgCyan, color.FgBlack),
+		ColorDiff:   color.New(color.FgYellow, color.Bold),
+		ColorInsert: color.New(color.FgGreen, color.Bold),
+		ColorMark:   color.New(color.FgRed, color.Bold),
+		ColorIndex:  backer,
+		ColorAddr:   backer,
+		AutoColors:  true,
+
+		MaxPerLine:     5,
+		AutoWidth:      true,
+		Width:          45,
+		PrettyByteRune: true,
+		ShowLen:        true,
+		ShowCap:        true,
+		ShowPtr:        true,
+		SortKeys:       true,
+		SpaceCharacter: ' ',
+		TerminalWidths: true,
+		FrameDelay:     500 * time.Millisecond,
+
+		Writer: color.Output,
+	}
+}
+
+// Option configures a Printer that is created by New
+type Option func(*Printer)
+
+// New creates a new Printer with the default settings and the given options
+func New(opts ...Option) *Printer {
+	p := NewPrinter()
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithWriter sets where the Printer draws the slices
+func WithWriter(w io.Writer) Option {
+	return func(p *Printer) { p.Writer = w }
+}
+
+// WithMaxPerLine sets the maximum number of slice items on a line
+func WithMaxPerLine(n int) Option {
+	return func(p *Printer) { p.MaxPerLine = n }
+}
+
+// WithWidth sets the width of the header
+func WithWidth(n int) Option {
+	return func(p *Printer) { p.Width = n }
+}
+
+// WithBacking sets whether the Printer draws the backing arrays
+func WithBacking(enabled bool) Option {
+	return func(p *Printer) { p.PrintBacking = enabled }
+}
+
+// WithoutBacking stops the Printer from drawing the backing arrays
+func WithoutBacking() Option {
+	return WithBacking(false)
+}
+
+// WithColors enables or disables the colors of the Printer
+func WithColors(enabled bool) Option {
+	return func(p *Printer) {
+		// the colors are copied: the other Printers and the package-level
+		// settings can share them
+		for _, c := range p.colors() {
+			if *c != nil {
+				cc := **c
+				*c = &cc
+			}
+		}
+		p.Colors(enabled)
+	}
+}
+
+// withOptions finds the Options among the slices of a call.
+// It returns a copy of the Printer with the Options, and the slices
+// without them. It returns the Printer itself if there are no Options.
+func (p *Printer) withOptions(slices []interface{}) (*Printer, []interface{}) {
+	var (
+		opts []Option
+		rest []interface{}
+	)
+	for _, s := range slices {
+		if opt, ok := s.(Option); ok {
+			opts = append(opts, opt)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+	if len(opts) == 0 {
+		return p, slices
+	}
+
+	wp := *p
+	for _, opt := range opts {
+		opt(&wp)
+	}
+	return &wp, rest
+}
+
+// global returns a Printer with the package-level settings.
+// The package-level functions delegate to it.
+func global() *Printer {
+	return &Printer{
+		ColorHeader: ColorHeader,
+		ColorSlice:  ColorSlice,
+		ColorBacker: ColorBacker,
+		ColorFill:   ColorFill,
+		ColorDiff:   ColorDiff,
+		ColorInsert: ColorInsert,
+		ColorMark:   ColorMark,
+		ColorIndex:  ColorIndex,
+		ColorAddr:   ColorAddr,
+		AutoColors:  AutoColors,
+		Highlight:   Highlight,
+		Labels:      Labels,
+
+		MaxPerLine:  MaxPerLine,
+		AutoWidth:   AutoWidth,
+		FitWidth:    FitWidth,
+		MaxElements: MaxElements,
+		Head:        Head,
+		Tail:        Tail,
+		Width:       Width,
+		Style:       Style,
+		Orientation: Orientation,
+		FillByValue: FillByValue,
+
+		StructFields:      StructFields,
+		IncludeFields:     IncludeFields,
+		ExcludeFields:     ExcludeFields,
+		PrettyByteRune:    PrettyByteRune,
+		PrintBacking:      PrintBacking,
+		PrintElementAddr:  PrintElementAddr,
+		PrintOffsets:      PrintOffsets,
+		PrintHex:          PrintHex,
+		RawPointer:        RawPointer,
+		PointerFormat:     PointerFormat,
+		ShowHeaderAddr:    ShowHeaderAddr,
+		ShowLen:           ShowLen,
+		ShowCap:           ShowCap,
+		ShowPtr:           ShowPtr,
+		ShowHash:          ShowHash,
+		ShowElemSize:      ShowElemSize,
+		ShowElemType:      ShowElemType,
+		SortKeys:          SortKeys,
+		StringAsBytes:     StringAsBytes,
+		RuneOffsets:       RuneOffsets,
+		PrintBytesHex:     PrintBytesHex,
+		PrintASCII:        PrintASCII,
+		ElementFormat:     ElementFormat,
+		BytesAs:           BytesAs,
+		TerminalWidths:    TerminalWidths,
+		SpaceCharacter:    SpaceCharacter,
+		NormalizePointers: NormalizePointers,
+		Formatter:         Formatter,
+
+		Collapsible: Collapsible,
+		Tooltips:    Tooltips,
+		FrameDelay:  FrameDelay,
+		Format:      Format,
+
+		Writer: Writer,
+
+		noColors: noColors,
+	}
+}
+
+// Colors is used to enable/disable the color data from the output
+func (p *Printer) Colors(enabled bool) {
+	p.noColors = !enabled
+
+	for _, c := range p.colors() {
+		if enabled {
+			(*c).EnableColor()
+		} else {
+			(*c).DisableColor()
+		}
+	}
+}
+
+// colors returns the color settings of the Printer
+func (p *Printer) colors() []**color.Color {
+	return []**color.Color{
+		&p.ColorHeader, &p.ColorSlice, &p.ColorBacker, &p.ColorIndex, &p.ColorFill,
+		&p.ColorDiff, &p.ColorInsert, &p.ColorMark, &p.ColorAddr,
+	}
+}
+
+// colored returns a copy of the Printer that draws the colors even
+// if the standard output is not a terminal: the images and the html
+// always need them. The colors are copied, so the settings of the
+// color package and the other Printers don't change.
+//
+// It returns the Printer itself if the colors are disabled with Colors(false).
+func (p *Printer) colored() *Printer {
+	if p.noColors {
+		return p
+	}
+	enabled := func(c *color.Color) *color.Color {
+		if c == nil {
+			return nil
+		}
+		cc := *c
+		cc.EnableColor()
+		return &cc
+	}
+
+	cp := *p
+	for _, c := range cp.colors() {
+		*c = enabled(*c)
+	}
+	if p.Highlight != nil {
+		cp.Highlight = make(map[int]*color.Color, len(p.Highlight))
+		for i, c := range p.Highlight {
+			cp.Highlight[i] = enabled(c)
+		}
+	}
+	return &cp
+}
@@ -0,0 +1,139 @@
+package prettyslice
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Recorder records the drawings of slices as frames to replay them later.
+//
+// It's useful for animating an algorithm: record the slice in each step
+// of the algorithm, and then replay the steps.
+type Recorder struct {
+	p      *Printer
+	frames []recording
+}
+
+// recording is a frame of a Recorder
+type recording struct {
+	// text is drawn like Show draws it
+	text string
+
+	// colored has the colors even if the Writer is not a terminal
+	colored string
+}
+
+// NewRecorder creates a new Recorder with the package-level settings.
+// The settings that are changed afterwards don't affect it.
+func NewRecorder() *Recorder {
+	return global().NewRecorder()
+}
+
+// NewRecorder creates a new Recorder with the Printer's settings.
+// The settings that are changed afterwards don't affect it.
+func (p *Printer) NewRecorder() *Recorder {
+	rp := *p
+	return &Recorder{p: &rp}
+}
+
+// Record draws slices as a new frame.
+// The slices can change afterwards, the frame doesn't.
+func (r *Recorder) Record(msg string, slices ...interface{}) {
+	var f recording
+
+	p, slices := r.p.withOptions(slices)
+	f.text = p.text(msg, slices...)
+	f.colored = p.colored().text(msg, slices...)
+
+	r.frames = append(r.frames, f)
+}
+
+// Len returns the number of the recorded frames
+func (r *Recorder) Len() int {
+	return len(r.frames)
+}
+
+// Frames returns the recorded frames as they're drawn by Show
+func (r *Recorder) Frames() []string {
+	frames := make([]string, len(r.frames))
+	for i, f := range r.frames {
+		frames[i] = f.text
+	}
+	return frames
+}
+
+// Replay draws the frames to the Writer one by one, FrameDelay apart.
+//
+// Each frame is drawn over the previous one if the Writer is a terminal,
+// otherwise, each frame is drawn after the previous one without a delay.
+func (r *Recorder) Replay() {
+	pl := r.p.newPlayer()
+
+	for i, f := range r.frames {
+		if i > 0 && pl.tty {
+			time.Sleep(r.p.FrameDelay)
+		}
+		pl.play(f.text)
+	}
+}
+
+// WriteFrames saves each frame as a numbered png image into a directory,
+// like: frame-000.png, frame-001.png...
+func (r *Recorder) WriteFrames(dir string) error {
+	for i, f := range r.frames {
+		name := filepath.Join(dir, fmt.Sprintf("frame-%03d.png", i))
+
+		file, err := os.Create(name)
+		if err != nil {
+			return err
+		}
+
+		err = png.Encode(file, textImage(f.colored, r.p.TerminalWidths))
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGIF saves the frames as an animated gif image, FrameDelay apart
+func (r *Recorder) WriteGIF(w io.Writer) error {
+	imgs := make([]*image.RGBA, len(r.frames))
+
+	// the frames can have different sizes: the image fits the biggest one
+	var bounds image.Rectangle
+	for i, f := range r.frames {
+		imgs[i] = textImage(f.colored, r.p.TerminalWidths)
+		bounds = bounds.Union(imgs[i].Bounds())
+	}
+
+	// gif delays are in 100ths of a second
+	delay := int(r.p.FrameDelay / (10 * time.Millisecond))
+
+	anim := &gif.GIF{Config: image.Config{
+		ColorModel: color.Palette(palette.Plan9),
+		Width:      bounds.Dx(),
+		Height:     bounds.Dy(),
+	}}
+	for _, img := range imgs {
+		frame := image.NewPaletted(bounds, palette.Plan9)
+		draw.Draw(frame, bounds, image.NewUniform(ansiBackground), image.Point{}, draw.Src)
+		draw.Draw(frame, img.Bounds(), img, image.Point{}, draw.Src)
+
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+	}
+	return gif.EncodeAll(w, anim)
+}
@@ -0,0 +1,86 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// run is a sequence of equal elements in a slice
+type run struct {
+	start, count int
+}
+
+// ShowRuns pretty prints the runs of equal elements in a slice.
+//
+// It draws one box per run with the run's value and length (like "a×3"),
+// and the index number of the run's first element below the box.
+func ShowRuns(msg string, slice interface{}) {
+	global().ShowRuns(msg, slice)
+}
+
+// ShowRuns pretty prints the runs of equal elements in a slice.
+func (p *Printer) ShowRuns(msg string, slice interface{}) {
+	buf := new(strings.Builder)
+
+	d := p.create(slice, buf)
+	d.header(msg)
+	d.pushNewline()
+
+	if s := d.slice; s.IsNil() {
+		d.push(d.nilText() + "\n")
+	} else if s.Len() == 0 {
+		d.push(d.emptyText() + "\n")
+	} else {
+		runs := runsOf(s)
+
+		var (
+			labels  = make([]string, len(runs))
+			indices = make([]int, len(runs))
+		)
+		for i, r := range runs {
+			v := p.format(r.start, s.Index(r.start))
+
+			labels[i] = v + p.Style.chars().times + strconv.Itoa(r.count)
+			indices[i] = d.index(r.start)
+		}
+
+		// the runs of a map are drawn with the keys of their first elements
+		var keys []string
+		if d.keys != nil {
+			keys = make([]string, len(runs))
+			for i, r := range runs {
+				keys[i] = d.keys[r.start]
+			}
+		}
+
+		// the labels are already formatted
+		lp := *p
+		lp.Formatter, lp.ElementFormat, lp.PrettyByteRune = nil, "", false
+
+		rd := lp.create(labels, buf)
+		rd.indices, rd.keys = indices, keys
+		rd.elements()
+	}
+
+	p.writeTo(p.Writer, buf.String())
+}
+
+// runsOf finds the runs of equal elements in a slice
+func runsOf(slice reflect.Value) []run {
+	var runs []run
+
+	for i := 0; i < slice.Len(); i++ {
+		if n := len(runs); n > 0 {
+			r := &runs[n-1]
+
+			prev := slice.Index(r.start).Interface()
+			if reflect.DeepEqual(prev, slice.Index(i).Interface()) {
+				r.count++
+				continue
+			}
+		}
+		runs = append(runs, run{start: i, count: 1})
+	}
+	return runs
+}
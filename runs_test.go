@@ -0,0 +1,44 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunsFormatOnce(t *testing.T) {
+	p := NewPrinter()
+	WithColors(false)(p)
+	p.Formatter = func(index int, v reflect.Value) (string, bool) {
+		return "<" + v.String() + ">", true
+	}
+
+	var buf strings.Builder
+	p.Writer = &buf
+	p.ShowRuns("runs", []string{"a", "a", "b"})
+
+	out := buf.String()
+	for _, want := range []string{"<a>×2", "<b>×1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got:\n%s\nwant %q", out, want)
+		}
+	}
+	if strings.Contains(out, "<<") {
+		t.Errorf("got:\n%s\nwant the labels formatted once", out)
+	}
+}
+
+func TestRunsOfMap(t *testing.T) {
+	p := NewPrinter()
+	WithColors(false)(p)
+	p.SortKeys = true
+
+	var buf strings.Builder
+	p.Writer = &buf
+	p.ShowRuns("runs", map[string]int{"a": 1, "b": 1, "c": 2})
+
+	lines := strings.Split(buf.String(), "\n")
+	if words, _ := columns(lines[4]); strings.Join(words, " ") != "a c" {
+		t.Errorf("got the labels %q, want the keys a and c:\n%s", words, buf.String())
+	}
+}
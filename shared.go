@@ -0,0 +1,202 @@
+package prettyslice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ShowShared pretty prints slices and finds out the ones that share
+// the same backing array.
+//
+// The slices that share a backing array are drawn under a single row
+// of the backing array. Below it, each slice's window is marked from its
+// first element to its last element, and its capacity is dotted.
+// The other slices are drawn like Show draws them.
+func ShowShared(msg string, slices ...interface{}) {
+	global().ShowShared(msg, slices...)
+}
+
+// ShowShared pretty prints slices and finds out the ones that share
+// the same backing array.
+func (p *Printer) ShowShared(msg string, slices ...interface{}) {
+	p, slices = p.withOptions(slices)
+	buf := new(strings.Builder)
+
+	for i, g := range sharedGroups(slices) {
+		// only draw the message for the first item (grouping)
+		if i > 0 {
+			msg = ""
+		}
+
+		if len(g) == 1 {
+			p.create(slices[g[0]], buf).draw(msg)
+			continue
+		}
+		p.shared(msg, slices, g, buf)
+	}
+
+	p.writeTo(p.Writer, buf.String())
+}
+
+// window is the memory region of a slice's capacity
+type window struct {
+	start, end uintptr
+}
+
+// windowOf returns the memory region of a slice's capacity.
+// ok is false if the slice cannot share a backing array.
+func windowOf(slice interface{}) (w window, ok bool) {
+	s := reflect.ValueOf(slice)
+	if s.Kind() != reflect.Slice || s.Cap() == 0 {
+		return w, false
+	}
+
+	size := s.Type().Elem().Size()
+	if size == 0 {
+		return w, false
+	}
+
+	w.start = s.Pointer()
+	w.end = w.start + uintptr(s.Cap())*size
+	return w, true
+}
+
+// overlaps is true if two windows share some memory
+func (w window) overlaps(o window) bool {
+	return w.start < o.end && o.start < w.end
+}
+
+// sharedGroups groups the indexes of the slices that share a backing array.
+// The groups are in the order of the slices' first appearance.
+//
+// The slices are grouped transitively: s[0:10:10] and s[20:] are in the same
+// group when s[5:25:25] overlaps both of them.
+func sharedGroups(slices []interface{}) [][]int {
+	// group is a union-find forest: the root of a group is its first slice
+	group := make([]int, len(slices))
+	for i := range group {
+		group[i] = i
+	}
+	var root func(i int) int
+	root = func(i int) int {
+		if group[i] != i {
+			group[i] = root(group[i])
+		}
+		return group[i]
+	}
+
+	for i := range slices {
+		wi, ok := windowOf(slices[i])
+		if !ok {
+			continue
+		}
+
+		for j := i + 1; j < len(slices); j++ {
+			wj, ok := windowOf(slices[j])
+			if !ok {
+				continue
+			}
+
+			ti := reflect.TypeOf(slices[i]).Elem()
+			tj := reflect.TypeOf(slices[j]).Elem()
+			if ti != tj || !wi.overlaps(wj) {
+				continue
+			}
+
+			// merge the groups into the one that appears first
+			ri, rj := root(i), root(j)
+			group[max(ri, rj)] = min(ri, rj)
+		}
+	}
+
+	var groups [][]int
+	at := make(map[int]int)
+	for i := range group {
+		g := root(i)
+		k, ok := at[g]
+		if !ok {
+			k = len(groups)
+			at[g] = k
+			groups = append(groups, nil)
+		}
+		groups[k] = append(groups[k], i)
+	}
+	return groups
+}
+
+// shared draws the slices of a group under their backing array
+func (p *Printer) shared(msg string, slices []interface{}, group []int, buf *strings.Builder) {
+	var (
+		vs   = make([]reflect.Value, len(group))
+		ws   = make([]window, len(group))
+		size = reflect.TypeOf(slices[group[0]]).Elem().Size()
+		all  window
+	)
+
+	for k, i := range group {
+		vs[k] = reflect.ValueOf(slices[i])
+		ws[k], _ = windowOf(slices[i])
+
+		if k == 0 || ws[k].start < all.start {
+			all.start = ws[k].start
+		}
+		if ws[k].end > all.end {
+			all.end = ws[k].end
+		}
+	}
+
+	// copy the backing array from the slices that can see its elements
+	n := int((all.end - all.start) / size)
+	backer := reflect.MakeSlice(vs[0].Type(), n, n)
+
+	for k, v := range vs {
+		off := int((ws[k].start - all.start) / size)
+		reflect.Copy(backer.Slice(off, n), v.Slice(0, v.Cap()))
+	}
+
+	// the markers are drawn below the whole backing array, on a single line
+	sp := *p
+	sp.MaxPerLine, sp.MaxElements, sp.FitWidth = 0, 0, 0
+	sp.Head, sp.Tail, sp.lineWidth = 0, 0, 0
+
+	d := sp.createValue(backer, buf)
+	d.headerWith(msg, fmt.Sprintf(" (shared by %d slices, cap:%d)", len(group), n))
+	d.pushNewline()
+	d.elements()
+
+	cells := d.cells(0, n)
+	for k, v := range vs {
+		off := int((ws[k].start - all.start) / size)
+		d.marker(cells, off, v.Len(), v.Cap())
+		d.pushf(d.ColorIndex, " [%d] len:%d cap:%d", group[k], v.Len(), v.Cap())
+		d.pushNewline()
+	}
+}
+
+// marker draws the window of a slice below the boxes of its backing array.
+// The elements are marked from off to off+l, and the capacity to off+c.
+func (d drawing) marker(cells []string, off, l, c int) {
+	cs := d.Style.chars()
+
+	for i, v := range cells {
+		// +4 is for the vertical bars and the spaces around the value
+		w := d.slen(v) + 4
+
+		switch {
+		case i >= off && i < off+l:
+			left, right := cs.markLine, cs.markLine
+			if i == off {
+				left = cs.markStart
+			}
+			if i == off+l-1 {
+				right = cs.markEnd
+			}
+			d.pushf(d.ColorSlice, "%s%s%s", left, strings.Repeat(cs.markLine, w-2), right)
+		case i >= off && i < off+c:
+			d.pushf(d.ColorBacker, "%s", strings.Repeat(cs.markCap, w))
+		default:
+			d.push(strings.Repeat(" ", w))
+		}
+	}
+}
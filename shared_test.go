@@ -0,0 +1,50 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSharedGroupsTransitive(t *testing.T) {
+	s := make([]int, 30)
+	other := make([]int, 5)
+
+	groups := sharedGroups([]interface{}{s[0:10:10], other, s[20:], s[5:25:25]})
+
+	want := [][]int{{0, 2, 3}, {1}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("got %v, want %v", groups, want)
+	}
+}
+
+func TestSharedMarkersSingleLine(t *testing.T) {
+	s := make([]int, 12)
+
+	p := NewPrinter()
+	WithColors(false)(p)
+	p.lineWidth = 20
+	p.Head, p.Tail = 2, 1
+
+	var buf strings.Builder
+	p.Writer = &buf
+	p.ShowShared("shared", s[:4], s[2:8])
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+
+	// the header, the boxes, the indexes and the markers
+	if got, want := len(lines), 1+4+2; got != want {
+		t.Fatalf("got %d lines, want %d:\n%s", got, want, buf.String())
+	}
+	if got := len(boxes(lines[1])); got != len(s) {
+		t.Errorf("got %d boxes, want %d:\n%s", got, len(s), buf.String())
+	}
+
+	// each marker is as wide as the boxes before its details
+	w := slen(lines[1])
+	for _, m := range lines[5:] {
+		if i := strings.Index(m, " ["); slen(m[:i]) != w {
+			t.Errorf("got a marker in %d columns, want %d:\n%s", slen(m[:i]), w, buf.String())
+		}
+	}
+}
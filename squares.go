@@ -1,93 +1,352 @@
 package prettyslice
 
 import (
+	"bytes"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/fatih/color"
 )
 
 // drawing pretty draws a slice
 type drawing struct {
+	// the settings of the drawing
+	*Printer
+
 	slice, backer reflect.Value
 
-	buf *strings.Builder
+	buf io.StringWriter
 
 	// draw multiple items or just one?
 	multiple bool
+
+	// notes are drawn below the indexes of the slice elements
+	notes []string
+
+	// widths are the minimum widths of the element values
+	widths []int
+
+	// fills are the fractions of the boxes to fill for the slice elements
+	fills []float64
+
+	// html escapes the strings and draws the colors as css styles
+	html bool
+
+	// cols are the indexes of the elements to draw in the backing array.
+	// nil means all the elements, and -1 is the gap of the elided elements.
+	cols []int
+
+	// indices are drawn instead of the element indexes when they're not nil
+	indices []int
+
+	// keys are the keys of the map elements, drawn instead of the indexes
+	keys []string
+
+	// noun is what is drawn, like a slice or a map: <nil map>
+	noun string
+
+	// fwidths are the widths of the field columns of the struct elements
+	fwidths []int
+
+	// hdr is the address of the slice header, if a pointer to the slice is drawn
+	hdr uintptr
+
+	// values are the formatted elements of the backing array.
+	// They're drawn instead of formatting the elements when they're not nil.
+	values []string
 }
 
-// Show pretty prints slices
+// Show pretty prints slices.
+//
+// The Options among the slices change the settings only for this call,
+// like: Show("nums", nums, WithoutBacking()).
 func Show(msg string, slices ...interface{}) {
-	buf := new(strings.Builder)
+	global().Show(msg, slices...)
+}
+
+// Sprint pretty prints slices into a string instead of the Writer.
+// The string contains the color codes, just like Show prints.
+func Sprint(msg string, slices ...interface{}) string {
+	return global().Sprint(msg, slices...)
+}
+
+// Fprint pretty prints slices into w instead of the Writer,
+// and returns the write error.
+func Fprint(w io.Writer, msg string, slices ...interface{}) error {
+	return global().Fprint(w, msg, slices...)
+}
+
+// ShowHighlight pretty prints a slice with its elements at the given
+// indexes drawn in the given colors.
+func ShowHighlight(msg string, highlights map[int]*color.Color, slice interface{}) {
+	global().ShowHighlight(msg, highlights, slice)
+}
+
+// ShowErr pretty prints slices like Show, and returns the write error.
+// It returns io.ErrShortWrite if the Writer doesn't write all of the output.
+func ShowErr(msg string, slices ...interface{}) error {
+	return global().ShowErr(msg, slices...)
+}
+
+// Show pretty prints slices.
+// The Options among the slices don't change the Printer.
+func (p *Printer) Show(msg string, slices ...interface{}) {
+	p.ShowErr(msg, slices...)
+}
+
+// ShowHighlight pretty prints a slice with its elements at the given
+// indexes drawn in the given colors.
+func (p *Printer) ShowHighlight(msg string, highlights map[int]*color.Color, slice interface{}) {
+	hp := *p
+	hp.Highlight = highlights
+	hp.Show(msg, slice)
+}
+
+// ShowErr pretty prints slices like Show, and returns the write error.
+func (p *Printer) ShowErr(msg string, slices ...interface{}) error {
+	p, slices = p.withOptions(slices)
+	return p.Fprint(p.Writer, msg, slices...)
+}
+
+// Fprint pretty prints slices into w instead of the Writer,
+// and returns the write error.
+func (p *Printer) Fprint(w io.Writer, msg string, slices ...interface{}) error {
+	p, slices = p.withOptions(slices)
+
+	// the terminal can be resized between the calls
+	if p.AutoWidth {
+		if tw := terminalWidth(w); tw > 0 {
+			wp := *p
+			wp.lineWidth = tw
+			p = &wp
+		}
+	}
+
+	return p.writeTo(w, p.Sprint(msg, slices...))
+}
+
+// Sprint pretty prints slices into a string instead of the Writer.
+// The string contains the color codes, just like Show prints.
+func (p *Printer) Sprint(msg string, slices ...interface{}) string {
+	p, slices = p.withOptions(slices)
+
+	switch p.Format {
+	case FormatHTML:
+		return p.HTML(msg, slices...)
+	case FormatSVG:
+		return p.SVG(msg, slices...)
+	case FormatMarkdown:
+		return p.Markdown(msg, slices...)
+	}
+
+	return p.text(msg, slices...)
+}
+
+// buffers are the buffers of the text drawings.
+// They're reused, so they don't grow again for each drawing.
+var buffers = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// text draws slices as text with the color codes
+func (p *Printer) text(msg string, slices ...interface{}) string {
+	buf := buffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer buffers.Put(buf)
 
 	for i, slice := range slices {
-		d := create(slice, buf)
+		d := p.create(slice, buf)
+		if i < len(p.values) {
+			d.values = p.values[i]
+		}
 
 		// only draw the message for the first item (grouping)
 		if i > 0 {
 			msg = ""
 		}
-		d.header(msg)
-		d.pushNewline()
+		d.draw(msg)
+	}
+	return buf.String()
+}
+
+// nilText returns the text that is drawn for a nil slice or a nil map
+func (d drawing) nilText() string {
+	return "<nil " + d.noun + ">"
+}
+
+// emptyText returns the text that is drawn for an empty slice or an empty map
+func (d drawing) emptyText() string {
+	return "<empty " + d.noun + ">"
+}
+
+// draw draws the header and the elements of the slice
+func (d drawing) draw(msg string) {
+	d.header(msg)
+	d.pushNewline()
+	d.body()
+}
+
+// body draws the elements of the slice
+func (d drawing) body() {
+	if s := d.slice; s.IsNil() {
+		d.push(d.nilText() + "\n")
+		return
+	} else if s.Len() == 0 {
+		d.push(d.emptyText() + "\n")
+		// keep processing: slice can have elements in the backing array
+	}
+
+	if d.multiple && d.slice.Type().Elem().Kind() == reflect.Slice {
+		d.nested()
+		return
+	}
+
+	if d.FillByValue {
+		d.fills = fills(d.slice)
+	}
+	if len(d.Labels) > 0 {
+		d.notes = d.labelNotes()
+	}
+	if d.Orientation == Vertical {
+		d.vertical()
+		return
+	}
+	d.elements()
+}
 
-		if s := d.slice; s.IsNil() {
-			d.push("<nil slice>\n")
+// nested draws the inner slices of a slice one below the other, with their headers
+func (d drawing) nested() {
+	d.cols = d.columns(d.slice.Len())
+
+	for k := 0; k < d.ncols(d.slice.Len()); k++ {
+		i := d.col(k)
+		if i < 0 {
+			d.pushf(d.ColorBacker, "  %s", d.gap(d.slice.Len()))
+			d.pushNewline()
 			continue
-		} else if s.Len() == 0 {
-			d.push("<empty slice>\n")
-			// keep processing: slice can have elements in the backing array
 		}
 
-		// draw the slice elements
-		l := d.backer.Len()
-		if !PrintBacking {
-			l = d.slice.Len()
-		}
+		buf := new(strings.Builder)
 
-		step := MaxPerLine
-		if step <= 0 {
-			step = l
-		}
+		in := d.createValue(d.slice.Index(i), buf)
+		in.html = d.html
+		// each inner slice has its own len, cap and backing array
+		in.pushf(d.ColorIndex, "[%s]%s", d.label(i), in.info())
+		in.pushNewline()
+		in.body()
 
-		for f := 0; f < l; f += step {
-			if enough(f) {
-				d.push(ColorBacker.Sprintf("...%d more...", l-f))
-				d.pushNewline()
-				break
+		// indent the inner slice under the outer one
+		for _, l := range strings.SplitAfter(buf.String(), "\n") {
+			if l != "" {
+				d.buf.WriteString("  " + l)
 			}
+		}
+	}
+}
 
-			t := f + step
+// elements draws the slice elements line by line
+func (d drawing) elements() {
+	l := d.length()
 
-			d.wrap("╔", "╗", f, t)
-			d.pushNewline()
-			d.middle(f, t)
+	// a window of the elements has its columns
+	if d.cols == nil {
+		d.cols = d.columns(l)
+	}
+	l = d.ncols(l)
+
+	if d.structs() {
+		d.fwidths = d.fieldWidths()
+	}
+
+	step := d.MaxPerLine
+	if step <= 0 || d.FitWidth > 0 {
+		step = l
+	}
+
+	for f, t := 0, 0; f < l; f = t {
+		// every row of a line draws the same elements
+		t = d.lineEnd(f, f+step, l)
+
+		cs := d.Style.chars()
+
+		d.wrap(cs.topLeft, cs.topRight, f, t)
+		d.pushNewline()
+		if d.structs() {
+			d.fieldNames(f, t)
 			d.pushNewline()
-			d.wrap("╚", "╝", f, t)
+		}
+		d.middle(f, t)
+		d.pushNewline()
+		d.wrap(cs.bottomLeft, cs.bottomRight, f, t)
+		d.pushNewline()
+		d.indexes(f, t)
+		d.pushNewline()
+
+		if d.PrintASCII && d.bytes() {
+			d.ascii(f, t)
 			d.pushNewline()
-			d.indexes(f, t)
+		}
+
+		if d.notes != nil {
+			d.annotations(f, t)
 			d.pushNewline()
+		}
 
-			if PrintElementAddr {
-				d.addresses(f, t)
-				d.pushNewline()
-			}
+		if d.PrintElementAddr {
+			d.addresses(f, t)
+			d.pushNewline()
 		}
 	}
-
-	// WriteString already checks for WriteString method
-	io.WriteString(Writer, buf.String())
 }
 
 // create initializes a new drawing struct.
-func create(slice interface{}, buf *strings.Builder) drawing {
-	s := reflect.ValueOf(slice)
+func (p *Printer) create(slice interface{}, buf io.StringWriter) drawing {
+	return p.createValue(reflect.ValueOf(slice), buf)
+}
+
+// createValue initializes a new drawing struct from a reflect.Value.
+func (p *Printer) createValue(s reflect.Value, buf io.StringWriter) drawing {
+	// draw the array that a pointer points to: its elements have real addresses
+	if s.Kind() == reflect.Ptr && s.Elem().Kind() == reflect.Array {
+		s = s.Elem()
+	}
+
+	var (
+		keys    []string
+		indices []int
+		hdr     uintptr
+	)
 
-	multiple := true
-	if s.Kind() != reflect.Slice {
+	// draw the slice that a pointer points to: it has the header's address
+	if s.Kind() == reflect.Ptr && !s.IsNil() && s.Elem().Kind() == reflect.Slice {
+		s, hdr = s.Elem(), s.Pointer()
+	}
+
+	multiple, noun := true, "slice"
+	switch s.Kind() {
+	case reflect.Slice:
+	case reflect.Array:
+		// an array has no separate backing array: its len and cap are the same
+		s = sliceArray(s)
+	case reflect.String:
+		// draw each character of a string in its own box
+		if !p.StringAsBytes && p.RuneOffsets {
+			indices = runeOffsets(s.String())
+		}
+		s = sliceString(s, p.StringAsBytes)
+	case reflect.Map:
+		// draw the values in boxes and their keys below them
+		s, keys = p.mapValues(s)
+		noun = "map"
+	default:
 		s = makeSlice(s)
 
 		// don't draw slice details for one item
@@ -95,123 +354,364 @@ func create(slice interface{}, buf *strings.Builder) drawing {
 	}
 
 	return drawing{
-		slice: s,
+		Printer: p,
+		slice:   s,
 		// this contains the backing array's data, after the slice's pointer.
 		backer:   s.Slice(0, s.Cap()),
 		multiple: multiple,
+		keys:     keys,
+		noun:     noun,
+		indices:  indices,
+		hdr:      hdr,
 		buf:      buf,
 	}
 }
 
 // header draws the header information about the slice with a message
 func (d drawing) header(msg string) {
-	var info string
-	if d.multiple {
-		f := " (len:%-2d cap:%-2d ptr:%-4d)"
-		if PrintHex {
-			f = " (len:%-2d cap:%-2d ptr:%-10x)"
-		}
-
-		info = fmt.Sprintf(
-			f,
-			d.slice.Len(), d.slice.Cap(), d.pointer(0),
-		)
-	}
+	d.headerWith(msg, d.info())
+}
 
+// headerWith draws the header with a message and the given details
+func (d drawing) headerWith(msg, info string) {
 	msg = " " + msg
 
-	w, l := Width, len(msg)+len(info)
+	w, l := d.Width, d.slen(msg)+d.slen(info)
 	w -= l
-	if l > Width {
+	if l > d.Width {
 		w = 1
 	}
 
-	d.push(ColorHeader.Sprintf("%s%*s%s", msg, w, "", info))
+	d.pushf(d.ColorHeader, "%s%*s%s", msg, w, "", info)
+}
+
+// info returns the details of the slice for the header
+func (d drawing) info() string {
+	if !d.multiple {
+		return ""
+	}
+
+	// the values of a map are copied: their cap and ptr are not the map's
+	showCap, showPtr := d.ShowCap && d.keys == nil, d.ShowPtr && d.keys == nil
+
+	var fields []string
+	if d.ShowLen {
+		fields = append(fields, fmt.Sprintf("len:%-2d", d.slice.Len()))
+	}
+	if showCap {
+		fields = append(fields, fmt.Sprintf("cap:%-2d", d.slice.Cap()))
+	}
+	if showPtr {
+		f := "ptr:%-4s"
+		if d.hexPointers() {
+			f = "ptr:%-10s"
+		}
+		if d.rawPointers() {
+			f = "ptr:%s"
+		}
+		fields = append(fields, fmt.Sprintf(f, d.ptr()))
+	}
+
+	var info string
+	if len(fields) > 0 {
+		// only the pointer is padded before the closing parenthesis
+		info = " (" + strings.Join(fields, " ")
+		if !showPtr {
+			info = strings.TrimRight(info, " ")
+		}
+		info += ")"
+	}
+
+	if d.ShowHeaderAddr && d.hdr != 0 {
+		info = appendInfo(info, "hdr:%#x", d.hdr)
+	}
+	if d.ShowHash {
+		info = appendInfo(info, "hash:%08x", d.hash())
+	}
+	if d.ShowElemSize {
+		info = appendInfo(info, "size:%d", d.slice.Type().Elem().Size())
+	}
+	if d.ShowElemType {
+		// for generic code, this is the type that T is instantiated with
+		info = appendInfo(info, "elem:%s", d.slice.Type().Elem())
+	}
+	return info
+}
+
+// ptr returns the pointer of the slice to draw in the header
+func (d drawing) ptr() string {
+	switch {
+	case d.rawPointers():
+		return fmt.Sprintf("%#x", d.slice.Pointer())
+	case d.hexPointers():
+		return strconv.FormatInt(d.pointer(0), 16)
+	}
+	return strconv.FormatInt(d.pointer(0), 10)
+}
+
+// appendInfo appends a detail into the parentheses of the header info
+func appendInfo(info, format string, a ...interface{}) string {
+	if info == "" {
+		return " (" + fmt.Sprintf(format, a...) + ")"
+	}
+	info = strings.TrimRight(strings.TrimSuffix(info, ")"), " ")
+	return info + " " + fmt.Sprintf(format, a...) + ")"
 }
 
 // indexes draws the index numbers on top of the slice elements
 func (d drawing) indexes(from, to int) {
-	for i, v := range over(d.backer, from, to) {
-		if !PrintBacking && d.backing(from+i) {
-			break
+	for i, v := range d.cells(from, to) {
+		// current index
+		ci := d.col(i + from)
+
+		var n string
+		if ci >= 0 {
+			n = d.label(ci)
 		}
 
+		d.pushf(d.ColorIndex, "%s", d.center(n, v))
+	}
+}
+
+// ascii draws the ascii characters of the byte elements below their indexes.
+// It draws a dot for the bytes that can't be printed.
+func (d drawing) ascii(from, to int) {
+	for i, v := range d.cells(from, to) {
 		// current index
-		ci := i + from
+		ci := d.col(i + from)
 
-		lp, rp := paddings(len(strconv.Itoa(ci)), slen(v))
-		lps := strings.Repeat(" ", lp)
+		var n string
+		if ci >= 0 {
+			n = "."
+			if b := d.backer.Index(ci).Uint(); b >= 0x20 && b < 0x7f {
+				n = string(rune(b))
+			}
+		}
 
-		d.push(ColorIndex.Sprintf("%s%-*d", lps, rp, ci))
+		d.pushf(d.ColorIndex, "%s", d.center(n, v))
 	}
 }
 
-// addresses draw element addresses
-func (d drawing) addresses(from, to int) {
-	for i, v := range over(d.backer, from, to) {
-		if !PrintBacking && d.backing(from+i) {
+// bytes is true if the elements are bytes
+func (d drawing) bytes() bool {
+	return d.backer.Type().Elem().Kind() == reflect.Uint8
+}
+
+// annotations draws the notes below the index numbers of the slice elements
+func (d drawing) annotations(from, to int) {
+	for i, v := range d.cells(from, to) {
+		// current index
+		ci := d.col(i + from)
+
+		if d.backing(ci) {
 			break
 		}
 
+		var n string
+		if ci >= 0 {
+			n = d.notes[ci]
+		}
+
+		d.pushf(d.ColorIndex, "%s", d.center(n, v))
+	}
+}
+
+// addresses draw element addresses
+func (d drawing) addresses(from, to int) {
+	for i, v := range d.cells(from, to) {
 		// current index
-		ci := i + from
+		ci := d.col(i + from)
+
+		var p string
+		if ci >= 0 {
+			p = d.address(ci)
+		}
+
+		d.pushf(d.ColorAddr, "%s", d.center(p, v))
+	}
+}
 
-		p := d.pointer(ci)
+// address returns the address of an element to draw
+func (d drawing) address(index int) string {
+	addr := d.backer.Index(index).Addr().Pointer()
 
-		lp, rp := paddings(len(strconv.FormatInt(p, 10)), slen(v))
-		lps := strings.Repeat(" ", lp)
+	switch {
+	case d.PrintOffsets:
+		return fmt.Sprintf("+%d", addr-d.slice.Pointer())
+	case d.rawPointers():
+		return fmt.Sprintf("%#x", addr)
+	case d.hexPointers():
+		return strconv.FormatInt(d.pointer(index), 16)
+	}
+	return strconv.FormatInt(d.pointer(index), 10)
+}
+
+// lineEnd returns where a line that starts from an element ends:
+// at to, or earlier if the boxes don't fit into the lineWidth.
+// There is at least one element on a line.
+func (d drawing) lineEnd(from, to, l int) int {
+	if to > l {
+		to = l
+	}
+	if d.lineWidth <= 0 {
+		return to
+	}
 
-		d.push(ColorAddr.Sprintf("%s%-*d", lps, rp, p))
+	var w int
+	for i, v := range d.cells(from, to) {
+		// +4 is for the vertical bars and the spaces around the value
+		w += d.slen(v) + 4
+		if w > d.lineWidth && i > 0 {
+			return from + i
+		}
 	}
+	return to
 }
 
 // wrap draws the header and the footer depending on the left and right values
 func (d drawing) wrap(left, right string, from, to int) {
-	for i, v := range over(d.backer, from, to) {
-		c, l, r, m := ColorSlice, left, right, "═"
+	cs := d.Style.chars()
 
-		if d.backing(from + i) {
-			if !PrintBacking {
-				break
-			}
-			c, l, r, m = ColorBacker, "+", "+", "-"
+	for i, v := range d.cells(from, to) {
+		// current index
+		ci := d.col(from + i)
+
+		c, l, r, m := d.ColorSlice, left, right, cs.horizontal
+
+		if ci < 0 {
+			c = d.ColorBacker
+		} else if d.backing(ci) {
+			c, l, r, m = d.ColorBacker, cs.backerCorner, cs.backerCorner, cs.backerHorizontal
+		}
+
+		if hc := d.highlight(ci); hc != nil {
+			c = hc
 		}
 
 		// draw the horizontal line
 		// +2 is for the left and right vertical bars
-		w := strings.Repeat(m, slen(v)+2)
+		w := strings.Repeat(m, d.slen(v)+2)
 
-		d.push(c.Sprintf("%s%s%s", l, w, r))
+		d.pushf(c, "%s%s%s", l, w, r)
 	}
 }
 
 // middle draws the item's value wrapped between pipes
 func (d drawing) middle(from, to int) {
-	for i, v := range over(d.backer, from, to) {
-		p, c := "║", ColorSlice
-		if d.backing(from + i) {
-			if !PrintBacking {
-				break
-			}
-			p, c = "|", ColorBacker
+	cs := d.Style.chars()
+
+	for i, v := range d.cells(from, to) {
+		// current index
+		ci := d.col(from + i)
+
+		p, c := cs.vertical, d.ColorSlice
+		hc := d.highlight(ci)
+
+		if ci < 0 {
+			c = d.ColorBacker
+		} else if d.backing(ci) {
+			p, c = cs.backerVertical, d.ColorBacker
+		} else if hc == nil && ci < len(d.fills) {
+			d.fill(v, p, c, d.fills[ci])
+			continue
+		}
+
+		if hc != nil {
+			c = hc
+		}
+
+		if d.html && d.Tooltips && ci >= 0 {
+			d.pushf(c, "%-2s", p)
+			d.buf.WriteString(span(c, v, d.tooltip(ci)))
+			d.pushf(c, "%2s", p)
+			continue
+		}
+
+		// Left Vertical : %-2[2]s
+		// Item Value    : %[1]s
+		//   (its width is the box's width: slen(v).
+		//    fmt can't pad it: it counts the runes, not the columns.)
+		// Right Vertical: %2[2]s
+		d.pushf(c, "%-2[2]s%[1]s%2[2]s", v, p)
+	}
+}
+
+// fill draws the item's value in a box that is partially filled like a gauge
+func (d drawing) fill(v, p string, c *color.Color, frac float64) {
+	// the spaces around the value are filled as well
+	in := []rune(" " + v + " ")
+	n := int(math.Round(frac * float64(len(in))))
+
+	d.pushf(c, "%s", p)
+	d.pushf(d.ColorFill, "%s", string(in[:n]))
+	d.pushf(c, "%s", string(in[n:]))
+	d.pushf(c, "%s", p)
+}
+
+// cells returns the element values to draw for the [from, to) range
+func (d drawing) cells(from, to int) []string {
+	if l := d.ncols(d.length()); to > l {
+		to = l
+	}
+
+	values := make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		v := d.gap(d.length())
+		if ci := d.col(i); ci >= 0 && d.structs() {
+			_, v = d.fields(ci)
+		} else if ci >= 0 {
+			v = d.value(ci)
+		}
+		values = append(values, v)
+	}
+
+	// widen the values so that the notes and the addresses fit into their boxes,
+	// and so that they have the minimum widths.
+	for i := range values {
+		ci := d.col(i + from)
+
+		w := 0
+		// they're beside the boxes in the vertical drawings
+		if ci >= 0 && d.Orientation != Vertical {
+			w = d.noteWidth(ci)
+		}
+		if ci >= 0 && ci < len(d.widths) && d.widths[ci] > w {
+			w = d.widths[ci]
 		}
 
-		// Left Vertical : %-2[3]s
-		// Item Value    : %-[1]*v
-		//   (its width is dynamically adjusted: slen(v))
-		// Right Vertical: %2[3]s
-		d.push(
-			c.Sprintf("%-2[3]s%-[1]*v%2[3]s",
-				slen(v), v, p),
-		)
+		if w > d.slen(values[i]) {
+			values[i] += strings.Repeat(" ", w-d.slen(values[i]))
+		}
+	}
+
+	if d.FitWidth <= 0 || len(values) == 0 || d.Orientation == Vertical {
+		return values
+	}
+	return d.fit(values)
+}
+
+// noteWidth returns the width of the widest note, address or key of an
+// element, without the spaces around its value.
+func (d drawing) noteWidth(index int) int {
+	w := 0
+	if index < len(d.notes) {
+		w = d.slen(d.notes[index]) - 2
+	}
+	if d.PrintElementAddr && d.slen(d.address(index))-2 > w {
+		w = d.slen(d.address(index)) - 2
+	}
+	if index < len(d.keys) && d.slen(d.keys[index])-2 > w {
+		w = d.slen(d.keys[index]) - 2
 	}
+	return w
 }
 
-// pointer simplifies the pointer data for easy viewing
+// pointer simplifies the pointer data for easy viewing.
+// the simplified pointers of different elements can be the same.
 func (d drawing) pointer(index int) int64 {
 	var s int64 = 1
 
-	if NormalizePointers && d.slice.Len() > 0 {
+	if d.normalPointers() && d.slice.Len() > 0 {
 		s = int64(d.backer.Index(index).Type().Size())
 	}
 
@@ -221,7 +721,7 @@ func (d drawing) pointer(index int) int64 {
 	}
 
 	trim := int64(10000) // get rid of the leading digits
-	if PrintHex {
+	if d.hexPointers() {
 		// do not trim the digits: p % p + 1 = p
 		trim = p + 1
 	}
@@ -229,6 +729,125 @@ func (d drawing) pointer(index int) int64 {
 	return (p / s) % trim
 }
 
+// rawPointers is true if the real pointers are printed
+func (p *Printer) rawPointers() bool {
+	return p.PointerFormat == PointerRaw || p.PointerFormat == PointerAuto && p.RawPointer
+}
+
+// hexPointers is true if all the digits of the pointers are printed as hexadecimals
+func (p *Printer) hexPointers() bool {
+	return p.PointerFormat == PointerHex || p.PointerFormat == PointerAuto && p.PrintHex
+}
+
+// normalPointers is true if the pointers are normalized by the element size
+func (p *Printer) normalPointers() bool {
+	return p.PointerFormat == PointerNormalized || p.PointerFormat == PointerAuto && p.NormalizePointers
+}
+
+// hash computes a checksum of the slice elements as they're drawn
+func (d drawing) hash() uint32 {
+	h := fnv.New32a()
+	for i := 0; i < d.slice.Len(); i++ {
+		io.WriteString(h, d.value(i))
+		// separate the elements: ["ab"] and ["a", "b"] are different
+		h.Write([]byte{0})
+	}
+	return h.Sum32()
+}
+
+// index returns the index number to draw for an element
+func (d drawing) index(i int) int {
+	if d.indices != nil {
+		return d.indices[i]
+	}
+	return i
+}
+
+// label returns the index number or the map key to draw for an element
+func (d drawing) label(i int) string {
+	if d.keys != nil {
+		return d.keys[i]
+	}
+	return strconv.Itoa(d.index(i))
+}
+
+// columns finds out the elements to draw from the first l elements.
+//
+// When there are more than Head and Tail, or MaxElements, it elides the
+// elements in the middle: it returns the first and the last ones with a -1
+// in between. It returns nil when all of them are drawn.
+func (d drawing) columns(l int) []int {
+	head, tail := max(d.Head, 0), max(d.Tail, 0)
+	if head == 0 && tail == 0 {
+		n := d.MaxElements
+		head, tail = (n+1)/2, n/2
+	}
+	if head+tail <= 0 || l <= head+tail {
+		return nil
+	}
+
+	cols := make([]int, 0, head+tail+1)
+	for i := 0; i < head; i++ {
+		cols = append(cols, i)
+	}
+	cols = append(cols, -1)
+	for i := l - tail; i < l; i++ {
+		cols = append(cols, i)
+	}
+	return cols
+}
+
+// gap returns the value of the gap box of the elided elements
+// from the first l elements.
+func (d drawing) gap(l int) string {
+	g := d.Style.chars().gap
+	if d.cols == nil {
+		return g
+	}
+	return fmt.Sprintf("%s %s more %s", g, thousands(l-len(d.cols)+1), g)
+}
+
+// thousands formats a number with commas, like: 99,950
+func thousands(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// ncols returns the number of columns to draw from the first l elements
+func (d drawing) ncols(l int) int {
+	if d.cols != nil {
+		return len(d.cols)
+	}
+	return l
+}
+
+// col returns the index of the element to draw in a column
+func (d drawing) col(i int) int {
+	if d.cols != nil {
+		return d.cols[i]
+	}
+	return i
+}
+
+// highlight returns the highlight color of an element, or nil
+func (d drawing) highlight(index int) *color.Color {
+	if index < 0 {
+		return nil
+	}
+	return d.Highlight[index]
+}
+
+// length returns the number of elements to draw
+func (d drawing) length() int {
+	if d.PrintBacking {
+		return d.backer.Len()
+	}
+	return d.slice.Len()
+}
+
 // backing is true if the index belongs to the backing array
 func (d drawing) backing(index int) bool {
 	return index >= d.slice.Len()
@@ -236,6 +855,20 @@ func (d drawing) backing(index int) bool {
 
 // push appends a new string into the drawing's buffer
 func (d drawing) push(s string) {
+	if d.html {
+		s = escapeHTML(s)
+	}
+	d.buf.WriteString(s)
+}
+
+// pushf appends a new colored string into the drawing's buffer
+func (d drawing) pushf(c *color.Color, format string, a ...interface{}) {
+	s := fmt.Sprintf(format, a...)
+	if d.html {
+		s = span(c, s, "")
+	} else {
+		s = c.Sprint(s)
+	}
 	d.buf.WriteString(s)
 }
 
@@ -244,6 +877,13 @@ func (d drawing) pushNewline() {
 	d.push("\n")
 }
 
+// center centers a string below a value in their display widths,
+// like the map keys: 日本
+func (d drawing) center(s, v string) string {
+	lp, rp := paddings(d.slen(s), d.slen(v))
+	return strings.Repeat(" ", lp) + s + strings.Repeat(" ", max(rp-d.slen(s), 0))
+}
+
 // paddings finds out the left and right paddings from two values' lengths
 func paddings(a, b int) (lp int, rp int) {
 	// middle length
@@ -266,85 +906,260 @@ func paddings(a, b int) (lp int, rp int) {
 	return
 }
 
-// slen gets the length of a utf-8 string.
+// slen gets the display width of a utf-8 string.
 // this is a func because it doesn't use the struct's data. it's stateless.
 func slen(s string) int {
-	return utf8.RuneCountInString(s)
+	var w int
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
 }
 
-// enough is true if the current is > MaxElements
-func enough(index int) bool {
-	return MaxElements != 0 && index >= MaxElements
+// slen gets the width of a utf-8 string: its display width,
+// or its number of runes if TerminalWidths is false.
+func (d drawing) slen(s string) int {
+	if !d.TerminalWidths {
+		return utf8.RuneCountInString(s)
+	}
+	return slen(s)
 }
 
-// over range overs a reflect.Value as []string
-// TODO (@inanc): Fix the unnecessary allocation
-func over(slice reflect.Value, from, to int) []string {
-	size := to - from
-	if MaxElements != 0 && size >= MaxElements {
-		size = MaxElements
+// truncate cuts the string so that it fits into the width
+func (d drawing) truncate(s string, width int) string {
+	if !d.TerminalWidths {
+		return truncateWith(s, width, func(rune) int { return 1 })
 	}
+	return truncateWith(s, width, runeWidth)
+}
 
-	values := make([]string, 0, size)
+// fit truncates or pads the values so that their boxes fill the FitWidth.
+// The truncated values end with the gap.
+func (d drawing) fit(values []string) []string {
+	width, gap := d.FitWidth, d.Style.chars().gap
 
-	if l := slice.Len(); to > l {
-		to = l
+	n := len(values)
+
+	for i, v := range values {
+		// distribute the remaining columns to the first boxes
+		// -4 is for the vertical bars and the spaces around the value
+		w := width/n - 4
+		if i < width%n {
+			w++
+		}
+		if w < 1 {
+			w = 1
+		}
+
+		if d.slen(v) > w {
+			v = d.truncate(v, w-1) + gap
+		}
+		v += strings.Repeat(" ", w-d.slen(v))
+		values[i] = v
 	}
+	return values
+}
 
-	for i := from; i < to; i++ {
-		if enough(i) {
-			break
+// fills finds out how much of the boxes to fill for a numeric slice.
+// Each fraction is scaled between the smallest and the biggest elements.
+// It returns nil if the slice is not numeric.
+func fills(slice reflect.Value) []float64 {
+	l := slice.Len()
+	if l == 0 {
+		return nil
+	}
+
+	nums := make([]float64, l)
+	for i := range nums {
+		switch v := slice.Index(i); v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			nums[i] = float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			nums[i] = float64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			nums[i] = v.Float()
+		default:
+			return nil
 		}
+	}
 
-		v := slice.Index(i)
-		s := fmt.Sprintf("%v", v)
+	lo, hi := nums[0], nums[0]
+	for _, n := range nums {
+		lo, hi = math.Min(lo, n), math.Max(hi, n)
+	}
 
-		// this will be overwritten if PrettyByteRune
-		if PrintBytesHex {
-			if b, ok := v.Interface().(byte); ok {
-				s = fmt.Sprintf("%02x", b)
-			}
+	for i, n := range nums {
+		// fill all the boxes when the elements are the same
+		nums[i] = 1
+		if hi > lo {
+			nums[i] = (n - lo) / (hi - lo)
 		}
+	}
+	return nums
+}
 
-		if PrettyByteRune {
-			var (
-				r      rune
-				isRune bool
-			)
-
-			switch v.Interface().(type) {
-			case byte:
-				r = rune(v.Uint())
-				isRune = !PrintBytesHex && true
-			case rune:
-				r = rune(v.Int())
-				isRune = true
-			case string:
-				str := v.String()
-
-				var buf strings.Builder
-				for _, r := range str {
-					buf.WriteRune(toSpace(r))
-				}
-				s = buf.String()
-			}
+// format returns the string of an element
+// index is the index of the element in the backing array
+func (p *Printer) format(index int, v reflect.Value) string {
+	if p.Formatter != nil {
+		if s, ok := p.Formatter(index, v); ok {
+			return s
+		}
+	}
 
-			if isRune {
-				s = string(toSpace(r))
-			}
+	// the other kinds are formatted as usual, like the strings
+	if p.ElementFormat != "" && integer(v) && v.CanInterface() {
+		return fmt.Sprintf(p.ElementFormat, v.Interface())
+	}
+
+	s, ok := quickFormat(v)
+	if !ok {
+		s = fmt.Sprintf("%v", v)
+	}
+
+	// draw the buffer usage of a channel instead of its pointer.
+	// reading from the channel would change it.
+	if v.Kind() == reflect.Chan {
+		s = "<nil>"
+		if !v.IsNil() {
+			s = fmt.Sprintf("%d/%d", v.Len(), v.Cap())
 		}
+	}
 
-		values = append(values, s)
+	// the unexported struct fields can't be interfaces
+	var x interface{}
+	if v.CanInterface() {
+		x = v.Interface()
 	}
-	return values
+
+	switch x := x.(type) {
+	case byte:
+		s = p.formatByte(x)
+	case rune:
+		s = p.formatRune(x)
+	case string:
+		s = p.formatString(x)
+	}
+
+	return s
 }
 
-func toSpace(r rune) (out rune) {
+// integer is true if the value is an integer, like a byte and a rune.
+// The value in an interface is checked instead of the interface.
+func integer(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// formatByte formats a byte element as the byteMode says
+func (p *Printer) formatByte(b byte) string {
+	switch p.byteMode() {
+	case ByteAsChar:
+		return p.char(rune(b), true)
+	case ByteAsHex:
+		return fmt.Sprintf("0x%02x", b)
+	case byteAsHexDigits:
+		return fmt.Sprintf("%02x", b)
+	}
+	return strconv.FormatUint(uint64(b), 10)
+}
+
+// formatRune formats a rune element
+func (p *Printer) formatRune(r rune) string {
+	if p.PrettyByteRune {
+		return p.char(r, false)
+	}
+	return strconv.FormatInt(int64(r), 10)
+}
+
+// formatString formats a string element
+func (p *Printer) formatString(s string) string {
+	if !p.PrettyByteRune {
+		return s
+	}
+	var buf strings.Builder
+	for _, r := range s {
+		buf.WriteRune(p.toSpace(r))
+	}
+	return buf.String()
+}
+
+// value returns the formatted element of the backing array at the index
+func (d drawing) value(index int) string {
+	if d.values != nil {
+		return d.values[index]
+	}
+	return d.format(index, d.backer.Index(index))
+}
+
+// quickFormat formats the elements of the basic types without fmt.
+// It's false for the other types, and for the named types
+// that can print themselves with their methods.
+func quickFormat(v reflect.Value) (string, bool) {
+	if v.Type().PkgPath() != "" {
+		return "", false
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	}
+	return "", false
+}
+
+// byteMode returns how to print the byte elements
+func (p *Printer) byteMode() ByteMode {
+	switch {
+	case p.BytesAs != ByteAuto:
+		return p.BytesAs
+	case p.PrintBytesHex:
+		return byteAsHexDigits
+	case p.PrettyByteRune:
+		return ByteAsChar
+	}
+	return ByteAsNumber
+}
+
+// char returns the character of a byte or a rune.
+// it escapes the characters that can't be seen, like: \n, \t, \x00.
+func (p *Printer) char(r rune, isByte bool) string {
+	switch {
+	case r == ' ':
+		return string(p.SpaceCharacter)
+	case isByte && r >= utf8.RuneSelf:
+		// it's not a character: it's a part of a multi-byte rune
+		return fmt.Sprintf(`\x%02x`, r)
+	case !unicode.IsPrint(r):
+		q := strconv.QuoteRune(r)
+		return q[1 : len(q)-1]
+	}
+	return string(r)
+}
+
+func (p *Printer) toSpace(r rune) (out rune) {
 	out = r
 
 	switch {
 	case unicode.IsSpace(r), unicode.IsControl(r):
-		out = SpaceCharacter
+		out = p.SpaceCharacter
 	}
 	return
 }
@@ -354,3 +1169,33 @@ func makeSlice(v reflect.Value) reflect.Value {
 	slice = reflect.Append(slice, v)
 	return slice
 }
+
+// sliceArray slices all the elements of an array.
+// An array that is passed by value is not addressable, so it's copied.
+func sliceArray(v reflect.Value) reflect.Value {
+	if !v.CanAddr() {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p.Elem()
+	}
+	return v.Slice(0, v.Len())
+}
+
+// runeOffsets returns the byte offsets of the runes in a string
+func runeOffsets(s string) []int {
+	var offsets []int
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	return offsets
+}
+
+// sliceString converts a string to a rune slice, or to a byte slice.
+// Like an array, a string has no spare capacity: its len and cap are the same.
+func sliceString(v reflect.Value, bytes bool) reflect.Value {
+	s := reflect.ValueOf([]rune(v.String()))
+	if bytes {
+		s = reflect.ValueOf([]byte(v.String()))
+	}
+	return s.Slice3(0, s.Len(), s.Len())
+}
@@ -0,0 +1,233 @@
+package prettyslice
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// drawLines draws a slice without the colors
+func drawLines(t *testing.T, p *Printer, slice interface{}) []string {
+	t.Helper()
+
+	WithColors(false)(p)
+	out := p.Sprint("test", slice)
+	return strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+}
+
+// boxes returns the columns where the boxes start and end on a border line
+func boxes(border string) (spans [][2]int) {
+	for i, r := range []rune(border) {
+		switch r {
+		case '╔':
+			spans = append(spans, [2]int{i, -1})
+		case '╗':
+			spans[len(spans)-1][1] = i
+		}
+	}
+	return spans
+}
+
+// columns returns the words of a line and the columns where they start
+func columns(line string) (words []string, cols []int) {
+	start := -1
+	for i, r := range []rune(line + " ") {
+		switch {
+		case r != ' ' && start < 0:
+			start = i
+		case r == ' ' && start >= 0:
+			words = append(words, string([]rune(line)[start:i]))
+			cols = append(cols, start)
+			start = -1
+		}
+	}
+	return words, cols
+}
+
+func TestWrappedIndexes(t *testing.T) {
+	p := NewPrinter()
+	p.MaxPerLine = 3
+	p.PrintBacking = false
+
+	lines := drawLines(t, p, []int{10, 11, 12, 13, 14, 15, 16})
+
+	// the header, and the top, middle, bottom and index rows of each line
+	if got, want := len(lines), 1+3*4; got != want {
+		t.Fatalf("got %d lines, want %d:\n%s", got, want, strings.Join(lines, "\n"))
+	}
+
+	wantCounts := []int{3, 3, 1}
+	next := 0
+
+	for l, count := range wantCounts {
+		top, indexes := lines[1+l*4], lines[1+l*4+3]
+
+		spans := boxes(top)
+		if len(spans) != count {
+			t.Fatalf("line #%d: got %d boxes, want %d: %q", l, len(spans), count, top)
+		}
+
+		words, cols := columns(indexes)
+		if len(words) != count {
+			t.Fatalf("line #%d: got %d indexes, want %d: %q", l, len(words), count, indexes)
+		}
+
+		// each index is below its box
+		for k, s := range spans {
+			if cols[k] < s[0] || cols[k] > s[1] {
+				t.Errorf("line #%d: index %s at column %d is not below its box %v", l, words[k], cols[k], s)
+			}
+			if want := strconv.Itoa(next); words[k] != want {
+				t.Errorf("line #%d: got index %s, want %s", l, words[k], want)
+			}
+			next++
+		}
+	}
+}
+
+func TestArrays(t *testing.T) {
+	var arr interface{} = [3]string{"a", "b", "c"}
+
+	tests := []struct {
+		name  string
+		slice interface{}
+		n     int
+	}{
+		{"ints", [5]int{1, 2, 3, 4, 5}, 5},
+		{"interface", arr, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := drawLines(t, NewPrinter(), tt.slice)
+
+			if got := len(boxes(lines[1])); got != tt.n {
+				t.Errorf("got %d boxes, want %d:\n%s", got, tt.n, strings.Join(lines, "\n"))
+			}
+
+			// an array has no separate backing array
+			n := strconv.Itoa(tt.n)
+			if h := lines[0]; !strings.Contains(h, "len:"+n) || !strings.Contains(h, "cap:"+n) {
+				t.Errorf("got header %q, want len == cap == %d", h, tt.n)
+			}
+		})
+	}
+}
+
+// showType draws a slice in a generic function
+func showType[T any](p *Printer, s []T) string {
+	var buf strings.Builder
+	p.Writer = &buf
+	p.Show("generic", s)
+	return buf.String()
+}
+
+func TestShowElemTypeInGeneric(t *testing.T) {
+	type point struct{ X, Y int }
+
+	p := NewPrinter()
+	p.ShowElemType = true
+
+	tests := []struct {
+		out  string
+		want string
+	}{
+		{showType(p, []int{1, 2}), "elem:int)"},
+		{showType(p, []point{{1, 2}}), "elem:prettyslice.point)"},
+	}
+	for _, tt := range tests {
+		if h, _, _ := strings.Cut(tt.out, "\n"); !strings.Contains(h, tt.want) {
+			t.Errorf("got header %q, want %s", h, tt.want)
+		}
+	}
+}
+
+func TestOptionsInEntryPoints(t *testing.T) {
+	p := NewPrinter()
+	WithColors(false)(p)
+	nums := make([]int, 3, 5)
+
+	// the Printer with the option
+	wp := *p
+	WithoutBacking()(&wp)
+
+	tests := []struct {
+		name      string
+		got, want string
+	}{
+		{"Markdown", p.Markdown("nums", nums, WithoutBacking()), wp.Markdown("nums", nums)},
+		{"HTML", p.HTML("nums", nums, WithoutBacking()), wp.HTML("nums", nums)},
+		{"SVG", p.SVG("nums", nums, WithoutBacking()), wp.SVG("nums", nums)},
+	}
+
+	r, wr := p.NewRecorder(), wp.NewRecorder()
+	r.Record("nums", nums, WithoutBacking())
+	wr.Record("nums", nums)
+	tests = append(tests, struct{ name, got, want string }{"Record", r.Frames()[0], wr.Frames()[0]})
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got:\n%s\nwant:\n%s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestElementFormatIntegers(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice interface{}
+		want  string
+	}{
+		{"ints", []int{10}, "║ 00001010 ║"},
+		{"runes", []rune{'a'}, "║ 01100001 ║"},
+		{"strings", []string{"a"}, "║ a ║"},
+		{"floats", []float64{1.5}, "║ 1.5 ║"},
+		{"mixed", []interface{}{2, "b"}, "║ 00000010 ║║ b ║"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPrinter()
+			p.ElementFormat = ElementBinary
+
+			if out := strings.Join(drawLines(t, p, tt.slice), "\n"); !strings.Contains(out, tt.want) {
+				t.Errorf("got:\n%s\nwant %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaps(t *testing.T) {
+	var m map[string]int
+	if lines := drawLines(t, NewPrinter(), m); lines[1] != "<nil map>" {
+		t.Errorf("got %q, want <nil map>", lines[1])
+	}
+	if lines := drawLines(t, NewPrinter(), map[string]int{}); lines[1] != "<empty map>" {
+		t.Errorf("got %q, want <empty map>", lines[1])
+	}
+
+	p := NewPrinter()
+	p.SortKeys = true
+	lines := drawLines(t, p, map[string]int{"日本": 1, "a": 22})
+
+	// each key is centered below its box
+	if got, want := slen(lines[4]), slen(lines[1]); got != want {
+		t.Errorf("got the keys in %d columns, want %d:\n%s", got, want, strings.Join(lines, "\n"))
+	}
+	spans := boxes(lines[1])
+
+	// a wide rune takes two columns
+	var keys []rune
+	for _, r := range lines[4] {
+		keys = append(keys, r)
+		if slen(string(r)) == 2 {
+			keys = append(keys, ' ')
+		}
+	}
+	for k, want := range []string{"a", "日本"} {
+		s := spans[k]
+		key := strings.ReplaceAll(strings.TrimSpace(string(keys[s[0]:s[1]+1])), " ", "")
+		if key != want {
+			t.Errorf("got key %q below box #%d, want %q:\n%s", key, k, want, strings.Join(lines, "\n"))
+		}
+	}
+}
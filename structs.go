@@ -0,0 +1,132 @@
+package prettyslice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structs is true if the fields of the struct elements are drawn separately.
+// The elements without the fields to draw are drawn like the other elements.
+func (d drawing) structs() bool {
+	t := d.backer.Type().Elem()
+	return d.StructFields && t.Kind() == reflect.Struct && len(d.structFields(t)) > 0
+}
+
+// fields returns the field names and the field values of a struct element.
+// they're separated into columns that have the same widths in every element.
+func (d drawing) fields(index int) (names, values string) {
+	v := d.backer.Index(index)
+
+	var ns, vs []string
+	for k, i := range d.structFields(v.Type()) {
+		n, s := v.Type().Field(i).Name, d.field(index, v.Field(i))
+
+		w := d.slen(n)
+		if d.slen(s) > w {
+			w = d.slen(s)
+		}
+		if k < len(d.fwidths) && d.fwidths[k] > w {
+			w = d.fwidths[k]
+		}
+
+		n += strings.Repeat(" ", w-d.slen(n))
+		s += strings.Repeat(" ", w-d.slen(s))
+		ns, vs = append(ns, n), append(vs, s)
+	}
+	sep := " " + d.Style.chars().field + " "
+	return strings.Join(ns, sep), strings.Join(vs, sep)
+}
+
+// field returns the value of a field of a struct element
+func (d drawing) field(index int, f reflect.Value) string {
+	if !f.CanInterface() {
+		return fmt.Sprintf("%v", f)
+	}
+	return d.format(index, f)
+}
+
+// structFields returns the indexes of the fields to draw: the exported ones
+// that are in IncludeFields, if it's not empty, and not in ExcludeFields.
+func (d drawing) structFields(t reflect.Type) []int {
+	var fs []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		switch {
+		case f.PkgPath != "": // unexported
+		case len(d.IncludeFields) > 0 && !hasName(d.IncludeFields, f.Name):
+		case hasName(d.ExcludeFields, f.Name):
+		default:
+			fs = append(fs, i)
+		}
+	}
+	return fs
+}
+
+// fieldWidths returns the widths of the field columns: the widest
+// field name or value in the struct elements that are drawn.
+func (d drawing) fieldWidths() []int {
+	fs := d.structFields(d.backer.Type().Elem())
+
+	widths := make([]int, len(fs))
+	for k := 0; k < d.ncols(d.length()); k++ {
+		// only the elements that are drawn
+		index := d.col(k)
+		if index < 0 {
+			continue
+		}
+		v := d.backer.Index(index)
+
+		for k, i := range fs {
+			w := d.slen(v.Type().Field(i).Name)
+			if s := d.field(index, v.Field(i)); d.slen(s) > w {
+				w = d.slen(s)
+			}
+			if w > widths[k] {
+				widths[k] = w
+			}
+		}
+	}
+	return widths
+}
+
+// hasName is true if the names have the name
+func hasName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldNames draws the field names of the struct elements between pipes
+func (d drawing) fieldNames(from, to int) {
+	cs := d.Style.chars()
+
+	for i, v := range d.cells(from, to) {
+		// current index
+		ci := d.col(from + i)
+
+		p, c := cs.vertical, d.ColorSlice
+		if ci >= 0 && d.backing(ci) {
+			p, c = cs.backerVertical, d.ColorBacker
+		}
+
+		n := cs.gap
+		if ci >= 0 {
+			n, _ = d.fields(ci)
+		}
+
+		// the values can be widened or truncated
+		if w := d.slen(v); d.slen(n) > w {
+			n = d.truncate(n, w)
+		}
+		n += strings.Repeat(" ", d.slen(v)-d.slen(n))
+
+		d.pushf(c, "%-2s", p)
+		d.pushf(d.ColorIndex, "%s", n)
+		d.pushf(c, "%2s", p)
+	}
+}
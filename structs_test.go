@@ -0,0 +1,33 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStructsWithoutFields(t *testing.T) {
+	type secret struct{ a, b int }
+	type point struct{ X, Y int }
+
+	tests := []struct {
+		name    string
+		include []string
+		slice   interface{}
+		want    string
+	}{
+		{"unexported", nil, []secret{{1, 2}}, "║ {1 2} ║"},
+		{"not included", []string{"Z"}, []point{{1, 2}}, "║ {1 2} ║"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPrinter()
+			p.StructFields = true
+			p.IncludeFields = tt.include
+
+			lines := drawLines(t, p, tt.slice)
+			if out := strings.Join(lines, "\n"); !strings.Contains(out, tt.want) {
+				t.Errorf("got:\n%s\nwant %q", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package prettyslice
+
+// BoxStyle is a set of characters to draw the boxes with
+type BoxStyle int
+
+const (
+	// StyleUnicode draws the boxes with double lines, like: ╔═══╗
+	StyleUnicode BoxStyle = iota
+
+	// StyleASCII draws the boxes only with ascii characters, like: +---+
+	StyleASCII
+
+	// StyleRounded draws the boxes with rounded corners, like: ╭───╮
+	StyleRounded
+)
+
+// charset has the characters of a style.
+// Each of them is drawn in one column: the layout is the same in every style.
+type charset struct {
+	// the slice's boxes
+	topLeft, topRight, bottomLeft, bottomRight string
+	horizontal, vertical                       string
+
+	// the backing array's boxes
+	backerCorner, backerHorizontal, backerVertical string
+
+	// separates the struct fields in a box
+	field string
+
+	// the elided elements and the truncated values
+	gap string
+
+	// the windows of the slices in ShowShared
+	markStart, markLine, markEnd, markCap string
+
+	// separates a value and its count in ShowRuns
+	times string
+
+	// a queued element in ShowChan
+	slot string
+
+	// point at an element from its label:
+	// below a horizontal element, and beside a vertical one
+	arrow, arrowLeft string
+}
+
+// charsets are the characters of the styles
+var charsets = map[BoxStyle]charset{
+	StyleUnicode: {
+		topLeft: "╔", topRight: "╗", bottomLeft: "╚", bottomRight: "╝",
+		horizontal: "═", vertical: "║",
+		backerCorner: "+", backerHorizontal: "-", backerVertical: "|",
+		field: "│", gap: "…",
+		markStart: "├", markLine: "─", markEnd: "┤", markCap: "·",
+		times: "×", arrow: "↑", arrowLeft: "←", slot: "●",
+	},
+	StyleASCII: {
+		topLeft: "+", topRight: "+", bottomLeft: "+", bottomRight: "+",
+		horizontal: "-", vertical: "|",
+		backerCorner: ".", backerHorizontal: ".", backerVertical: ":",
+		field: "|", gap: "~",
+		markStart: "|", markLine: "-", markEnd: "|", markCap: ".",
+		times: "x", arrow: "^", arrowLeft: "<", slot: "*",
+	},
+	StyleRounded: {
+		topLeft: "╭", topRight: "╮", bottomLeft: "╰", bottomRight: "╯",
+		horizontal: "─", vertical: "│",
+		backerCorner: "+", backerHorizontal: "-", backerVertical: "|",
+		field: "│", gap: "…",
+		markStart: "├", markLine: "─", markEnd: "┤", markCap: "·",
+		times: "×", arrow: "↑", arrowLeft: "←", slot: "●",
+	},
+}
+
+// chars returns the characters of the style.
+// It returns the unicode characters for an unknown style.
+func (s BoxStyle) chars() charset {
+	if cs, ok := charsets[s]; ok {
+		return cs
+	}
+	return charsets[StyleUnicode]
+}
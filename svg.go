@@ -0,0 +1,105 @@
+package prettyslice
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// svgCellWidth and svgCellHeight are the size of a character in pixels
+	svgCellWidth  = 9
+	svgCellHeight = 18
+)
+
+// SVG draws slices as an svg image that can be embedded into web pages.
+//
+// It draws the same boxes that Show draws with the configured colors.
+// The colors are drawn even if the Writer is not a terminal,
+// unless they're disabled with Colors(false).
+func SVG(msg string, slices ...interface{}) string {
+	return global().SVG(msg, slices...)
+}
+
+// SVG draws slices as an svg image that can be embedded into web pages.
+func (p *Printer) SVG(msg string, slices ...interface{}) string {
+	p, slices = p.withOptions(slices)
+	out := p.colored().text(msg, slices...)
+
+	lines := parseANSI(strings.TrimSuffix(out, "\n"))
+	if p.TerminalWidths {
+		for i, l := range lines {
+			lines[i] = terminalCells(l)
+		}
+	}
+
+	var cols int
+	for _, l := range lines {
+		if len(l) > cols {
+			cols = len(l)
+		}
+	}
+
+	buf := new(strings.Builder)
+
+	w, h := cols*svgCellWidth, len(lines)*svgCellHeight
+	fmt.Fprintf(buf,
+		`<svg xmlns="http://www.w3.org/2000/svg" class="prettyslice" width="%d" height="%d" font-family="monospace" font-size="15">`+"\n",
+		w, h)
+	fmt.Fprintf(buf, `<rect width="%d" height="%d" fill="%s"/>`+"\n", w, h, hex(ansiBackground))
+
+	for y, l := range lines {
+		// draw the cells that have the same colors together
+		for x := 0; x < len(l); {
+			n := 1
+			for x+n < len(l) && sameColors(l[x], l[x+n]) {
+				n++
+			}
+			svgRun(buf, l[x:x+n], x, y)
+			x += n
+		}
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.String()
+}
+
+// svgRun draws the cells that have the same colors, starting from the x-th column
+func svgRun(buf *strings.Builder, cells []ansiCell, x, y int) {
+	var (
+		px = x * svgCellWidth
+		py = y * svgCellHeight
+		pw = len(cells) * svgCellWidth
+	)
+
+	c := cells[0]
+	if c.bg != nil {
+		fmt.Fprintf(buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+			px, py, pw, svgCellHeight, hex(c.bg))
+	}
+
+	var s strings.Builder
+	for _, c := range cells {
+		// the second cell of a wide rune is empty
+		if c.r != 0 {
+			s.WriteRune(c.r)
+		}
+	}
+	if strings.TrimSpace(s.String()) == "" {
+		return
+	}
+
+	var bold string
+	if c.bold {
+		bold = ` font-weight="bold"`
+	}
+
+	// the text is stretched to the cells: the columns stay aligned
+	fmt.Fprintf(buf,
+		`<text x="%d" y="%d" fill="%s"%s textLength="%d" lengthAdjust="spacingAndGlyphs" xml:space="preserve">%s</text>`+"\n",
+		px, py+svgCellHeight-5, hex(c.fg), bold, pw, escapeHTML(s.String()))
+}
+
+// sameColors is true if two cells are drawn in the same colors
+func sameColors(a, b ansiCell) bool {
+	return a.fg == b.fg && a.bg == b.bg && a.bold == b.bold
+}
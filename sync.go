@@ -0,0 +1,33 @@
+package prettyslice
+
+import (
+	"io"
+	"sync"
+)
+
+// writeMu writes one drawing at a time: the concurrent drawings
+// never interleave even if the Writer splits the writes.
+var writeMu sync.Mutex
+
+// write writes a drawing at once, and returns the write error.
+// It returns io.ErrShortWrite if w doesn't write all of the drawing.
+func write(w io.Writer, out string) error {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	// WriteString already checks for WriteString method
+	n, err := io.WriteString(w, out)
+	if err == nil && n < len(out) {
+		err = io.ErrShortWrite
+	}
+	return err
+}
+
+// writeTo writes a drawing to w like write does.
+// It removes the colors if w shouldn't draw them.
+func (p *Printer) writeTo(w io.Writer, out string) error {
+	if !p.colorful(w) {
+		out = stripANSI(out)
+	}
+	return write(w, out)
+}
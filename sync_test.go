@@ -0,0 +1,55 @@
+package prettyslice
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+// failWriter fails after writing n bytes
+type failWriter struct {
+	n   int
+	err error
+}
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, w.err
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestWriteErrors(t *testing.T) {
+	errFull := errors.New("disk is full")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"error", errFull, errFull},
+		{"short write", nil, io.ErrShortWrite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/ShowErr", func(t *testing.T) {
+			p := NewPrinter()
+			p.Writer = &failWriter{n: 10, err: tt.err}
+
+			if err := p.ShowErr("nums", []int{1, 2, 3}); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+
+		t.Run(tt.name+"/Fprint", func(t *testing.T) {
+			w := &failWriter{n: 10, err: tt.err}
+
+			if err := NewPrinter().Fprint(w, "nums", []int{1, 2, 3}); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
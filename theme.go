@@ -0,0 +1,123 @@
+package prettyslice
+
+import (
+	"github.com/fatih/color"
+)
+
+// Theme is a set of colors to draw the slices with.
+// Its colors are the same as the Color settings, see them for the details.
+type Theme struct {
+	Header *color.Color
+	Slice  *color.Color
+	Backer *color.Color
+	Fill   *color.Color
+	Diff   *color.Color
+	Insert *color.Color
+	Mark   *color.Color
+	Index  *color.Color
+	Addr   *color.Color
+}
+
+var (
+	// ThemeDark has the default colors: for the terminals with a dark background
+	ThemeDark = Theme{
+		Header: color.New(color.BgHiBlack, color.FgMagenta, color.Bold),
+		Slice:  color.New(color.FgCyan),
+		Backer: color.New(color.FgHiBlack),
+		Fill:   color.New(color.BgCyan, color.FgBlack),
+		Diff:   color.New(color.FgYellow, color.Bold),
+		Insert: color.New(color.FgGreen, color.Bold),
+		Mark:   color.New(color.FgRed, color.Bold),
+		Index:  color.New(color.FgHiBlack),
+		Addr:   color.New(color.FgHiBlack),
+	}
+
+	// ThemeLight is for the terminals with a light background
+	ThemeLight = Theme{
+		Header: color.New(color.BgHiWhite, color.FgBlue, color.Bold),
+		Slice:  color.New(color.FgBlue),
+		Backer: color.New(color.FgHiBlack),
+		Fill:   color.New(color.BgBlue, color.FgHiWhite),
+		Diff:   color.New(color.FgMagenta, color.Bold),
+		Insert: color.New(color.FgGreen, color.Bold),
+		Mark:   color.New(color.FgRed, color.Bold),
+		Index:  color.New(color.FgHiBlack),
+		Addr:   color.New(color.FgHiBlack),
+	}
+
+	// ThemeMonochrome draws without colors: only with bold, faint,
+	// underlined and reversed characters
+	ThemeMonochrome = Theme{
+		Header: color.New(color.ReverseVideo, color.Bold),
+		Slice:  color.New(color.Reset),
+		Backer: color.New(color.Faint),
+		Fill:   color.New(color.ReverseVideo),
+		Diff:   color.New(color.Bold),
+		Insert: color.New(color.Bold, color.Underline),
+		Mark:   color.New(color.ReverseVideo, color.Bold),
+		Index:  color.New(color.Faint),
+		Addr:   color.New(color.Faint),
+	}
+
+	// ThemeSolarized has the colors of the Solarized palette.
+	// It needs a terminal with the 24-bit colors.
+	ThemeSolarized = Theme{
+		Header: color.RGB(211, 54, 130).AddBgRGB(7, 54, 66).Add(color.Bold),
+		Slice:  color.RGB(42, 161, 152),
+		Backer: color.RGB(88, 110, 117),
+		Fill:   color.RGB(0, 43, 54).AddBgRGB(42, 161, 152),
+		Diff:   color.RGB(181, 137, 0).Add(color.Bold),
+		Insert: color.RGB(133, 153, 0).Add(color.Bold),
+		Mark:   color.RGB(220, 50, 47).Add(color.Bold),
+		Index:  color.RGB(88, 110, 117),
+		Addr:   color.RGB(88, 110, 117),
+	}
+
+	// Themes are the themes by their names.
+	// Add your own themes to select them by their names as well,
+	// like from a flag: SetTheme(Themes[*theme]).
+	Themes = map[string]Theme{
+		"dark":       ThemeDark,
+		"light":      ThemeLight,
+		"monochrome": ThemeMonochrome,
+		"solarized":  ThemeSolarized,
+	}
+)
+
+// SetTheme sets the package-level colors to the theme's colors.
+// The nil colors of the theme don't change the colors.
+func SetTheme(t Theme) {
+	ColorHeader = pick(t.Header, ColorHeader)
+	ColorSlice = pick(t.Slice, ColorSlice)
+	ColorBacker = pick(t.Backer, ColorBacker)
+	ColorFill = pick(t.Fill, ColorFill)
+	ColorDiff = pick(t.Diff, ColorDiff)
+	ColorInsert = pick(t.Insert, ColorInsert)
+	ColorMark = pick(t.Mark, ColorMark)
+	ColorIndex = pick(t.Index, ColorIndex)
+	ColorAddr = pick(t.Addr, ColorAddr)
+}
+
+// SetTheme sets the Printer's colors to the theme's colors.
+// The nil colors of the theme don't change the colors.
+func (p *Printer) SetTheme(t Theme) {
+	p.ColorHeader = pick(t.Header, p.ColorHeader)
+	p.ColorSlice = pick(t.Slice, p.ColorSlice)
+	p.ColorBacker = pick(t.Backer, p.ColorBacker)
+	p.ColorFill = pick(t.Fill, p.ColorFill)
+	p.ColorDiff = pick(t.Diff, p.ColorDiff)
+	p.ColorInsert = pick(t.Insert, p.ColorInsert)
+	p.ColorMark = pick(t.Mark, p.ColorMark)
+	p.ColorIndex = pick(t.Index, p.ColorIndex)
+	p.ColorAddr = pick(t.Addr, p.ColorAddr)
+}
+
+// pick returns a copy of the theme's color, or the current color if it's nil.
+// The copy can be disabled with Colors(false) without changing the theme.
+func pick(theme, current *color.Color) *color.Color {
+	if theme == nil {
+		return current
+	}
+	c := *theme
+	return &c
+}
@@ -0,0 +1,63 @@
+package prettyslice
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ShowRunningTotal pretty prints a numeric slice with the running totals
+// drawn below its elements.
+//
+// Each running total is the sum of the elements up to and including
+// the element above it. Non-numeric slices are drawn like Show does.
+func ShowRunningTotal(msg string, slice interface{}) {
+	global().ShowRunningTotal(msg, slice)
+}
+
+// ShowRunningTotal pretty prints a numeric slice with the running totals
+// drawn below its elements.
+func (p *Printer) ShowRunningTotal(msg string, slice interface{}) {
+	buf := new(strings.Builder)
+
+	d := p.create(slice, buf)
+	d.notes = runningTotals(d.slice)
+	d.draw(msg)
+
+	p.writeTo(p.Writer, buf.String())
+}
+
+// runningTotals returns the prefix sums of a numeric slice as strings.
+// It returns nil if the slice is not numeric.
+func runningTotals(slice reflect.Value) []string {
+	var (
+		totals = make([]string, slice.Len())
+
+		i int64
+		u uint64
+		f float64
+	)
+
+	for k := range totals {
+		v := slice.Index(k)
+
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i += v.Int()
+			totals[k] = strconv.FormatInt(i, 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			u += v.Uint()
+			totals[k] = strconv.FormatUint(u, 10)
+		case reflect.Float64:
+			f += v.Float()
+			totals[k] = strconv.FormatFloat(f, 'g', -1, 64)
+		case reflect.Float32:
+			// sum in float32 like the elements: 0.1 is not 0.10000000149011612
+			f = float64(float32(f + v.Float()))
+			totals[k] = strconv.FormatFloat(f, 'g', -1, 32)
+		default:
+			return nil
+		}
+	}
+	return totals
+}
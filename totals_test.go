@@ -0,0 +1,25 @@
+package prettyslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRunningTotals(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice interface{}
+		want  []string
+	}{
+		{"ints", []int{1, -2, 3}, []string{"1", "-1", "2"}},
+		{"uints", []uint8{200, 100}, []string{"200", "300"}},
+		{"float64s", []float64{0.5, 0.25}, []string{"0.5", "0.75"}},
+		{"float32s", []float32{0.1, 0.2}, []string{"0.1", "0.3"}},
+		{"strings", []string{"a"}, nil},
+	}
+	for _, tt := range tests {
+		if got := runningTotals(reflect.ValueOf(tt.slice)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
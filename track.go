@@ -0,0 +1,156 @@
+package prettyslice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Tracker tracks the len, cap and pointer of a slice at the checkpoints,
+// and explains how the slice grows.
+//
+// It answers the questions like: when does append grow the backing array,
+// how much does it grow, and does the slice move to a new backing array?
+type Tracker struct {
+	p    *Printer
+	name string
+
+	// ptr points to the tracked slice
+	ptr reflect.Value
+
+	checks []checkpoint
+}
+
+// checkpoint is the slice's header at a checkpoint of a Tracker
+type checkpoint struct {
+	msg string
+
+	// slice only has the header of the slice: its elements can change
+	slice reflect.Value
+}
+
+// Track starts tracking the slice that slicePtr points to, like: &nums.
+// It records the first checkpoint with the slice as it is now.
+//
+// It panics if slicePtr is not a pointer to a slice.
+func Track(name string, slicePtr interface{}) *Tracker {
+	return global().Track(name, slicePtr)
+}
+
+// Track starts tracking the slice that slicePtr points to, like: &nums.
+// It records the first checkpoint with the slice as it is now.
+//
+// It panics if slicePtr is not a pointer to a slice.
+func (p *Printer) Track(name string, slicePtr interface{}) *Tracker {
+	v := reflect.ValueOf(slicePtr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		panic(fmt.Sprintf("prettyslice: Track needs a pointer to a slice, not %T", slicePtr))
+	}
+
+	tp := *p
+	t := &Tracker{p: &tp, name: name, ptr: v}
+	t.Check("start")
+	return t
+}
+
+// Check records a checkpoint with the slice's len, cap and pointer as they're now
+func (t *Tracker) Check(msg string) {
+	// copy the header: the slice that ptr points to changes
+	s := reflect.ValueOf(t.ptr.Elem().Interface())
+	t.checks = append(t.checks, checkpoint{msg: msg, slice: s})
+}
+
+// Show draws the growth history of the slice
+func (t *Tracker) Show() {
+	t.p.writeTo(t.p.Writer, t.Sprint())
+}
+
+// Sprint draws the growth history of the slice into a string.
+//
+// Each checkpoint is drawn with the slice's len, cap and pointer. When the
+// cap changes, it's drawn with the old and the new caps, the growth factor,
+// and whether the slice moved to a new backing array.
+func (t *Tracker) Sprint() string {
+	buf := new(strings.Builder)
+
+	rows := [][]string{{"", "checkpoint", "len", "cap", "ptr", "growth"}}
+	for i, c := range t.checks {
+		d := t.p.createValue(c.slice, buf)
+		rows = append(rows, []string{
+			fmt.Sprintf("#%d", i), c.msg,
+			fmt.Sprint(c.slice.Len()), fmt.Sprint(c.slice.Cap()),
+			d.ptr(), t.growth(i),
+		})
+	}
+
+	// the width of each column is the width of its widest cell
+	widths := make([]int, len(rows[0]))
+	for _, r := range rows {
+		for k, s := range r {
+			if w := slen(s); w > widths[k] {
+				widths[k] = w
+			}
+		}
+	}
+
+	d := t.p.createValue(t.ptr.Elem(), buf)
+	d.headerWith(t.name+" growth", fmt.Sprintf(" (%d checkpoints)", len(t.checks)))
+	d.pushNewline()
+
+	for i, r := range rows {
+		c := d.ColorSlice
+		switch {
+		case i == 0:
+			c = d.ColorIndex
+		case t.moved(i - 1):
+			c = d.ColorDiff
+		}
+
+		var cells []string
+		for k, s := range r {
+			cells = append(cells, s+strings.Repeat(" ", widths[k]-slen(s)))
+		}
+		d.pushf(c, " %s", strings.TrimRight(strings.Join(cells, "  "), " "))
+		d.pushNewline()
+	}
+	return buf.String()
+}
+
+// growth explains how the slice changed since the previous checkpoint
+func (t *Tracker) growth(i int) string {
+	if i == 0 {
+		return ""
+	}
+	prev, cur := t.checks[i-1].slice, t.checks[i].slice
+
+	var g string
+	if pc, cc := prev.Cap(), cur.Cap(); pc != cc {
+		g = fmt.Sprintf("cap %d %s %d", pc, arrowRight(t.p.Style), cc)
+		if pc > 0 {
+			g += fmt.Sprintf(" %s%.2f", t.p.Style.chars().times, float64(cc)/float64(pc))
+		}
+	}
+	if t.moved(i) {
+		g = strings.TrimSpace(g + " moved")
+	}
+	return g
+}
+
+// moved is true if the slice is in a new backing array at a checkpoint
+func (t *Tracker) moved(i int) bool {
+	if i == 0 {
+		return false
+	}
+	prev, cur := t.checks[i-1].slice, t.checks[i].slice
+
+	// an empty slice may not have a backing array yet
+	return prev.Cap() > 0 && cur.Cap() > 0 && prev.Pointer() != cur.Pointer()
+}
+
+// arrowRight returns the arrow between the old and the new caps
+func arrowRight(s BoxStyle) string {
+	if s == StyleASCII {
+		return "->"
+	}
+	return "→"
+}
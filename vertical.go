@@ -0,0 +1,162 @@
+package prettyslice
+
+import (
+	"strings"
+)
+
+// vertical draws the slice elements one below the other.
+//
+// Each row has the index of an element, its value in a box, and its notes
+// and address beside the box. The slice's elements and the backing array's
+// elements are drawn in separate boxes.
+func (d drawing) vertical() {
+	l := d.length()
+
+	// a window of the elements has its columns
+	if d.cols == nil {
+		d.cols = d.columns(l)
+	}
+	l = d.ncols(l)
+
+	if d.structs() {
+		d.fwidths = d.fieldWidths()
+	}
+
+	values := d.cells(0, l)
+
+	// the index column and the boxes have the same widths in every row
+	var iw, w int
+	for k, v := range values {
+		if ci := d.col(k); ci >= 0 && d.slen(d.label(ci)) > iw {
+			iw = d.slen(d.label(ci))
+		}
+		if d.slen(v) > w {
+			w = d.slen(v)
+		}
+	}
+
+	// squeeze the long values into the terminal
+	// +5 is for the space after the index, the vertical bars and their spaces
+	if lw := d.lineWidth - iw - 5; d.lineWidth > 0 && w > lw && lw > 1 {
+		w = lw
+	}
+	for k, v := range values {
+		if d.slen(v) > w {
+			v = d.truncate(v, w-1) + d.Style.chars().gap
+		}
+		values[k] = v + strings.Repeat(" ", w-d.slen(v))
+	}
+
+	if d.structs() {
+		d.verticalFields(iw, w)
+	}
+
+	// the box of the previous row: 0 is none, 1 is the slice's, 2 is the backing array's
+	var prev int
+	for k, v := range values {
+		ci := d.col(k)
+
+		// the gap stays in the box of the previous row
+		box := prev
+		if ci >= 0 && d.backing(ci) {
+			box = 2
+		} else if ci >= 0 || box == 0 {
+			box = 1
+		}
+
+		if box != prev {
+			if prev != 0 {
+				d.border(prev, false, iw, w)
+			}
+			d.border(box, true, iw, w)
+		}
+		prev = box
+
+		d.row(ci, iw, v)
+	}
+	if prev != 0 {
+		d.border(prev, false, iw, w)
+	}
+}
+
+// verticalFields draws the field names of the struct elements above the boxes
+func (d drawing) verticalFields(iw, w int) {
+	// the names are the same in every element
+	var n string
+	for k := 0; k < d.ncols(d.length()); k++ {
+		if ci := d.col(k); ci >= 0 {
+			n, _ = d.fields(ci)
+			break
+		}
+	}
+	if d.slen(n) > w {
+		n = d.truncate(n, w)
+	}
+
+	// +3 is for the space after the index, the vertical bar and its space
+	d.pushf(d.ColorIndex, "%*s%s", iw+3, "", n)
+	d.pushNewline()
+}
+
+// border draws the top or the bottom of the slice's box (box 1)
+// or the backing array's box (box 2)
+func (d drawing) border(box int, top bool, iw, w int) {
+	cs := d.Style.chars()
+
+	c, l, r, m := d.ColorSlice, cs.bottomLeft, cs.bottomRight, cs.horizontal
+	if top {
+		l, r = cs.topLeft, cs.topRight
+	}
+	if box == 2 {
+		c, l, r, m = d.ColorBacker, cs.backerCorner, cs.backerCorner, cs.backerHorizontal
+	}
+
+	// +2 is for the spaces around the values
+	d.push(strings.Repeat(" ", iw+1))
+	d.pushf(c, "%s%s%s", l, strings.Repeat(m, w+2), r)
+	d.pushNewline()
+}
+
+// row draws the index, the value, the notes and the address of an element.
+// The index is -1 for the gap of the elided elements.
+func (d drawing) row(ci, iw int, v string) {
+	var n string
+	if ci >= 0 {
+		n = d.label(ci)
+	}
+	d.pushf(d.ColorIndex, "%*s ", iw, n)
+
+	p, c := d.Style.chars().vertical, d.ColorSlice
+	hc := d.highlight(ci)
+
+	switch {
+	case ci < 0:
+		c = d.ColorBacker
+	case d.backing(ci):
+		p, c = d.Style.chars().backerVertical, d.ColorBacker
+	}
+	if hc != nil {
+		c = hc
+	}
+
+	if hc == nil && ci >= 0 && !d.backing(ci) && ci < len(d.fills) {
+		d.fill(v, p, c, d.fills[ci])
+	} else {
+		d.pushf(c, "%-2[2]s%[1]s%2[2]s", v, p)
+	}
+
+	if ci >= 0 && d.PrintASCII && d.bytes() {
+		n := "."
+		if b := d.backer.Index(ci).Uint(); b >= 0x20 && b < 0x7f {
+			n = string(rune(b))
+		}
+		d.pushf(d.ColorIndex, " %s", n)
+	}
+	if ci >= 0 && ci < len(d.notes) && d.notes[ci] != "" {
+		d.pushf(d.ColorIndex, " %s", d.notes[ci])
+	}
+	if ci >= 0 && d.PrintElementAddr {
+		d.pushf(d.ColorAddr, " %s", d.address(ci))
+	}
+	d.pushNewline()
+}
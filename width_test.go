@@ -0,0 +1,28 @@
+package prettyslice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWideRunes(t *testing.T) {
+	lines := drawLines(t, NewPrinter(), []string{"a", "世界", "🎉"})
+
+	top, values, bottom := lines[1], lines[2], lines[3]
+
+	// the borders and the values have the same width on a terminal
+	if w := slen(top); slen(values) != w || slen(bottom) != w {
+		t.Errorf("got widths top:%d values:%d bottom:%d, want the same widths:\n%s",
+			w, slen(values), slen(bottom), strings.Join(lines[1:4], "\n"))
+	}
+}
+
+func TestRuneWidth(t *testing.T) {
+	tests := map[rune]int{'a': 1, '世': 2, '🎉': 2, '\u0301': 0, '\u200d': 0}
+
+	for r, want := range tests {
+		if got := runeWidth(r); got != want {
+			t.Errorf("runeWidth(%U) = %d, want %d", r, got, want)
+		}
+	}
+}